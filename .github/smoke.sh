@@ -0,0 +1,100 @@
+#!/usr/bin/env bash
+# Runs the server against the database in a config and walks through the API.
+# usage: smoke.sh <binary> <config>
+set -euo pipefail
+
+bin=$1
+cfg=$2
+api=http://127.0.0.1:1337/api/v1
+sid=76561198092541763
+pid=
+
+start() {
+  "$bin" -cfile "$cfg" &
+  pid=$!
+  for _ in $(seq 30); do
+    if curl -sf $api/ping > /dev/null; then
+      return
+    fi
+    sleep 1
+  done
+  echo "server didn't come up" >&2
+  exit 1
+}
+
+stop() {
+  if [ -n "$pid" ]; then
+    kill $pid
+    wait $pid || true
+    pid=
+  fi
+}
+trap stop EXIT
+
+# status codes are sent in the body, check runs jq over the response and fails the script if it's false.
+check() {
+  local what=$1 filter=$2
+  shift 2
+  local body
+  body=$(curl -s "$@")
+  if ! jq -e "$filter" <<< "$body" > /dev/null; then
+    echo "FAIL $what: $body" >&2
+    exit 1
+  fi
+  echo "ok   $what"
+}
+
+start
+
+uid=$(curl -s -X POST $api/character/ -d '{"steamid":"'$sid'","slot":1,"size":3,"data":"AAAA"}' | jq -r .data.id)
+check "create" '.code == 200 and .data.version == 1' $api/character/id/$uid
+check "slot is taken" '.code == 409' -X POST $api/character/ -d '{"steamid":"'$sid'","slot":1,"size":3,"data":"AAAA"}'
+check "bad size" '.code == 422' -X POST $api/character/ -d '{"steamid":"'$sid'","slot":2,"size":4,"data":"AAAA"}'
+check "get by slot" '.data.id == "'$uid'"' $api/character/$sid/1
+
+check "update" '.code == 200 and .data.data == "BBBB" and .data.version == 2' -X PUT $api/character/$uid -H 'If-Match: "1"' -d '{"size":3,"data":"BBBB"}'
+check "stale update" '.code == 412' -X PUT $api/character/$uid -H 'If-Match: "1"' -d '{"size":3,"data":"CCCC"}'
+check "update without version" '.code == 428' -X PUT $api/character/$uid -d '{"size":3,"data":"CCCC"}'
+check "versions" '.data | length == 1' $api/character/$uid/versions
+check "rollback" '.code == 200 and .data.data == "AAAA"' -X POST $api/character/$uid/rollback/1
+
+check "lock ttl too long" '.code == 400' -X POST $api/character/$uid/lock -H 'X-Server-ID: eu1' -d '{"ttl":99999999999999}'
+check "lock" '.code == 200 and .data.server == "eu1"' -X POST $api/character/$uid/lock -H 'X-Server-ID: eu1'
+check "locked update" '.code == 423' -X PUT $api/character/$uid -H 'X-Server-ID: eu2' -H 'If-Match: *' -d '{"size":3,"data":"CCCC"}'
+check "locked delete" '.code == 423' -X DELETE $api/character/$uid -H 'X-Server-ID: eu2'
+check "unlock" '.data == true' -X DELETE $api/character/$uid/lock -H 'X-Server-ID: eu1'
+check "lock audit" '[.data[].action] | index("lock.acquire") != null and index("lock.release") != null' "$api/admin/audit?steamid=$sid"
+
+check "ban" '.code == 200' -X POST $api/ban/ -d '{"steamid":"'$sid'","reason":"smoke test"}'
+check "bans" '.data | map(.steamid) | index("'$sid'") != null' $api/ban/
+check "lift ban" '.data == 1' -X DELETE $api/ban/$sid
+
+check "register server" '.data.online == true' -X POST $api/servers/ -d '{"name":"eu1","address":"127.0.0.1:27015","map":"edana","max_players":16}'
+check "servers" '.data | map(.name) | index("eu1") != null' $api/servers/
+
+check "delete" '.code == 200' -X DELETE $api/character/$uid
+check "deleted" '.data | map(.id) | index("'$uid'") != null' $api/admin/character/deleted
+check "restore" '.code == 200 and .data.id == "'$uid'"' -X PATCH $api/admin/character/$uid/restore
+check "restore live" '.code == 404' -X PATCH $api/admin/character/$uid/restore
+check "restore unknown" '.code == 404' -X PATCH $api/admin/character/00000000-0000-0000-0000-000000000000/restore
+check "audit" '.data | map(.action) | index("character.create") != null' "$api/admin/audit?steamid=$sid"
+
+# a deleted character stays restorable after its slot is reused.
+old=$(curl -s -X POST $api/character/ -d '{"steamid":"'$sid'","slot":2,"size":3,"data":"AAAA"}' | jq -r .data.id)
+check "delete slot 2" '.code == 200' -X DELETE $api/character/$old
+check "reuse slot 2" '.code == 200' -X POST $api/character/ -d '{"steamid":"'$sid'","slot":2,"size":3,"data":"BBBB"}'
+check "still deleted" '.data | map(.id) | index("'$old'") != null' $api/admin/character/deleted
+check "restore into taken slot" '.code == 409' -X PATCH $api/admin/character/$old/restore
+check "versions of deleted" '.code == 404' $api/character/$old/versions
+check "rollback deleted" '.code == 404' -X POST $api/character/$old/rollback/1
+
+backup=$(mktemp)
+curl -sf $api/admin/backup -o "$backup"
+tar -xzOf "$backup" manifest.json | jq -e '.tables.characters == 3' > /dev/null
+rm "$backup"
+echo "ok   backup"
+
+# a second start runs the migrations over the schema they made.
+stop
+start
+check "restart" '.data.id == "'$uid'"' $api/character/$sid/1
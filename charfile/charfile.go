@@ -0,0 +1,68 @@
+//Package charfile checks character data sent by game servers before it's stored.
+//
+//Only the envelope is checked for now: the data has to be valid base64 and decode to the size
+//the server claims. The sections inside a save (header, stats, skills, inventory, quickslots)
+//aren't decoded since the MSC save layout isn't documented anywhere in this repo, and guessing
+//at it would turn away good saves.
+package charfile
+
+import (
+  "fmt"
+  "strings"
+  "encoding/base64"
+)
+
+//FieldError is one problem with a character payload.
+type FieldError struct {
+  Field string `json:"field"`
+  Reason string `json:"reason"`
+}
+
+//ValidationError lists everything wrong with a character payload.
+type ValidationError struct {
+  Errors []FieldError `json:"errors"`
+}
+
+func (e *ValidationError) Error() string {
+  reasons := make([]string, len(e.Errors))
+  for i,fe := range e.Errors {
+    reasons[i] = fe.Field + ": " + fe.Reason
+  }
+  
+  return "invalid character data: " + strings.Join(reasons, ", ")
+}
+
+func (e *ValidationError) add(field, format string, args ...interface{}) {
+  e.Errors = append(e.Errors, FieldError{field, fmt.Sprintf(format, args...)})
+}
+
+//Decode turns stored character data back into the bytes of the .char file.
+func Decode(data string) ([]byte, error) {
+  return base64.StdEncoding.DecodeString(data)
+}
+
+//Validate checks data decodes and that size matches the decoded length, returning a *ValidationError if not.
+func Validate(data string, size int) error {
+  verr := &ValidationError{}
+  
+  if data == "" {
+    verr.add("data", "is empty")
+    return verr
+  }
+  
+  raw, err := Decode(data)
+  if err != nil {
+    verr.add("data", "is not valid base64: %v", err)
+    return verr
+  }
+  
+  if size != len(raw) {
+    verr.add("size", "is %d but data decodes to %d bytes", size, len(raw))
+  }
+  
+  if len(verr.Errors) > 0 {
+    return verr
+  }
+  
+  return nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+  "os"
+  "time"
+  "fmt"
+  "flag"
+  "errors"
+  "context"
+  "strings"
+  "path/filepath"
+  
+  "github.com/msrevive/nexus2/service"
+  "github.com/msrevive/nexus2/helper"
+  "github.com/msrevive/nexus2/ent"
+  "github.com/msrevive/nexus2/log"
+)
+
+//runCommand runs a subcommand given after the flags instead of starting the server.
+func runCommand(args []string) error {
+  switch args[0] {
+  case "import":
+    return importCommand(args[1:])
+  case "backup":
+    return backupCommand(args[1:])
+  case "restore":
+    return restoreCommand(args[1:])
+  default:
+    return fmt.Errorf("unknown command %q", args[0])
+  }
+}
+
+//import [-dry-run] [-policy skip|overwrite] <dir|file.zip>
+func importCommand(args []string) error {
+  fs := flag.NewFlagSet("import", flag.ContinueOnError)
+  dryRun := fs.Bool("dry-run", false, "Report what would be imported without writing anything.")
+  policy := fs.String("policy", service.ImportSkip, "What to do when a slot already has a character: skip or overwrite.")
+  if err := fs.Parse(args); err != nil {
+    return err
+  }
+  if fs.NArg() != 1 {
+    return errors.New("usage: import [-dry-run] [-policy skip|overwrite] <dir|file.zip>")
+  }
+  
+  files, err := readCharFiles(fs.Arg(0))
+  if err != nil {
+    return err
+  }
+  
+  ctx := service.WithAuditor(context.Background(), ent.AuditEvent{Actor: "cli"})
+  res, err := service.New(ctx).CharactersImport(files, *policy, *dryRun)
+  if err != nil {
+    return err
+  }
+  
+  for _,e := range res.Entries {
+    if e.Error != "" {
+      log.Log.Warnf("%s: %s", e.File, e.Error)
+    }
+  }
+  log.Log.Printf("Imported %d files: %d created, %d updated, %d skipped, %d failed (dry run: %v)", len(res.Entries), res.Created, res.Updated, res.Skipped, res.Failed, res.DryRun)
+  
+  return nil
+}
+
+func readCharFiles(path string) ([]helper.CharFile, error) {
+  fi, err := os.Stat(path)
+  if err != nil {
+    return nil, err
+  }
+  if fi.IsDir() {
+    return helper.ReadCharDir(path)
+  }
+  if !strings.EqualFold(filepath.Ext(path), ".zip") {
+    return nil, fmt.Errorf("%s is not a directory or zip file", path)
+  }
+  
+  f, err := os.Open(path)
+  if err != nil {
+    return nil, err
+  }
+  defer f.Close()
+  
+  return helper.ReadCharZip(f, fi.Size())
+}
+
+//backup [-o file]
+func backupCommand(args []string) error {
+  fs := flag.NewFlagSet("backup", flag.ContinueOnError)
+  out := fs.String("o", service.BackupName(time.Now()), "Where to write the backup.")
+  if err := fs.Parse(args); err != nil {
+    return err
+  }
+  
+  f, err := os.OpenFile(*out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+  if err != nil {
+    return err
+  }
+  
+  manifest, err := service.New(context.Background()).Backup(f)
+  if cerr := f.Close(); err == nil {
+    err = cerr
+  }
+  if err != nil {
+    os.Remove(*out)
+    return err
+  }
+  
+  log.Log.Printf("Backed up %v to %s", manifest.Tables, *out)
+  return nil
+}
+
+//restore <file>
+func restoreCommand(args []string) error {
+  if len(args) != 1 {
+    return errors.New("usage: restore <file>")
+  }
+  
+  f, err := os.Open(args[0])
+  if err != nil {
+    return err
+  }
+  defer f.Close()
+  
+  manifest, err := service.New(context.Background()).Restore(f)
+  if err != nil {
+    return err
+  }
+  
+  log.Log.Printf("Restored %v from a %s backup taken %s", manifest.Tables, manifest.Version, manifest.CreatedAt.Format(time.RFC3339))
+  return nil
+}
@@ -0,0 +1,174 @@
+package controller
+
+import (
+  "time"
+  "errors"
+  "net/http"
+  
+  "github.com/msrevive/nexus2/response"
+  "github.com/msrevive/nexus2/service"
+  "github.com/msrevive/nexus2/ent"
+  "github.com/msrevive/nexus2/middleware"
+  "github.com/msrevive/nexus2/log"
+  
+  "github.com/google/uuid"
+  "github.com/gorilla/mux"
+)
+
+//GET /admin/character/deleted
+func (c *controller) GetDeletedCharacters(w http.ResponseWriter, r *http.Request) {
+  chars, err := service.New(r.Context()).CharactersGetDeleted()
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  response.OK(w, chars)
+}
+
+//PATCH /admin/character/{uid}/restore
+func (c *controller) RestoreCharacter(w http.ResponseWriter, r *http.Request) {
+  vars := mux.Vars(r)
+  uid, err := uuid.Parse(vars["uid"])
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  char, err := service.New(auditor(r)).CharacterRestore(uid)
+  if err != nil {
+    log.Log.Errorln(err)
+    if errors.Is(err, service.ErrCharacterExists) {
+      response.Conflict(w, err)
+      return
+    }
+    if ent.IsNotFound(err) {
+      response.NotFound(w, err)
+      return
+    }
+    response.Error(w, err)
+    return
+  }
+  
+  response.OK(w, char)
+}
+
+//GET /admin/locks
+func (c *controller) GetLocks(w http.ResponseWriter, r *http.Request) {
+  locks, err := service.New(r.Context()).LocksGetActive()
+  if err != nil {
+    log.Log.Errorln(err)
+    response.Error(w, err)
+    return
+  }
+  
+  response.OK(w, locks)
+}
+
+//DELETE /admin/locks/{uid}
+func (c *controller) ReleaseLock(w http.ResponseWriter, r *http.Request) {
+  vars := mux.Vars(r)
+  uid, err := uuid.Parse(vars["uid"])
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  if err := service.New(auditor(r)).LockForceRelease(uid); err != nil {
+    log.Log.Errorln(err)
+    if ent.IsNotFound(err) {
+      response.NotFound(w, err)
+      return
+    }
+    response.Error(w, err)
+    return
+  }
+  
+  response.Result(w, true)
+}
+
+//GET /admin/backup
+func (c *controller) GetBackup(w http.ResponseWriter, r *http.Request) {
+  w.Header().Set("Content-Type", "application/gzip")
+  w.Header().Set("Content-Disposition", `attachment; filename="`+service.BackupName(time.Now())+`"`)
+  
+  //the snapshot is read before anything is written, so a failure there can still be reported.
+  if _, err := service.New(r.Context()).Backup(w); err != nil {
+    log.Log.Errorln(err)
+    response.Error(w, err)
+    return
+  }
+}
+
+//GET /admin/backup/status
+func (c *controller) GetBackupStatus(w http.ResponseWriter, r *http.Request) {
+  response.OK(w, service.BackupStatusGet())
+}
+
+//GET /admin/anomalies?status=open
+func (c *controller) GetAnomalies(w http.ResponseWriter, r *http.Request) {
+  status := service.AnomalyOpen
+  if v, ok := r.URL.Query()["status"]; ok {
+    status = v[0]
+  }
+  
+  anomalies, err := service.New(r.Context()).AnomaliesGet(status)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.Error(w, err)
+    return
+  }
+  
+  response.OK(w, anomalies)
+}
+
+//POST /admin/anomalies/{id}/{approve|dismiss}
+func (c *controller) ReviewAnomaly(w http.ResponseWriter, r *http.Request) {
+  vars := mux.Vars(r)
+  id, err := uuid.Parse(vars["id"])
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  approve := vars["review"] == "approve"
+  
+  a, err := service.New(auditor(r)).AnomalyReview(id, approve, middleware.ClientID(r))
+  if err != nil {
+    log.Log.Errorln(err)
+    switch {
+    case errors.Is(err, service.ErrAnomalyReviewed):
+      response.Conflict(w, err)
+    case errors.Is(err, service.ErrVersionMismatch):
+      response.PreconditionFailed(w, err)
+    case errors.Is(err, service.ErrCharacterLocked):
+      response.Locked(w, err)
+    default:
+      response.Error(w, err)
+    }
+    return
+  }
+  
+  response.OK(w, a)
+}
+
+//POST /admin/reload
+func (c *controller) PostReload(w http.ResponseWriter, r *http.Request) {
+  if err := service.New(r.Context()).ListsReload(); err != nil {
+    log.Log.Errorln(err)
+    response.Error(w, err)
+    return
+  }
+  
+  //the lists are already reloaded, but a reload nobody can trace should still be reported.
+  if err := service.New(auditor(r)).AuditRecord(ent.AuditEvent{Action: service.AuditListsReload}); err != nil {
+    log.Log.Errorln(err)
+    response.Error(w, err)
+    return
+  }
+  
+  response.Result(w, true)
+}
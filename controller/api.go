@@ -25,27 +25,7 @@ func (c *controller) GetMapVerify(w http.ResponseWriter, r *http.Request) {
     return
   }
   
-  if res,_ := system.MapList[name]; res == uint32(hash) {
-    response.Result(w, true)
-    return
-  }
-  
-  response.Result(w, false)
-  return
-}
-
-//GET ban/{steamid}
-//in this case false means player isn't banned
-func (c *controller) GetBanVerify(w http.ResponseWriter, r *http.Request) {
-  if !system.Config.Verify.EnforceBan {
-    response.Result(w, false)
-    return
-  }
-  
-  vars := mux.Vars(r)
-  steamid := vars["steamid"]
-  
-  if _,ok := system.BanList[steamid]; ok {
+  if res,_ := system.MapListGet(name); res == uint32(hash) {
     response.Result(w, true)
     return
   }
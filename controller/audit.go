@@ -0,0 +1,67 @@
+package controller
+
+import (
+  "time"
+  "context"
+  "strconv"
+  "net/http"
+  
+  "github.com/msrevive/nexus2/response"
+  "github.com/msrevive/nexus2/service"
+  "github.com/msrevive/nexus2/middleware"
+  "github.com/msrevive/nexus2/ent"
+  "github.com/msrevive/nexus2/log"
+)
+
+//the request context, marked with who made the request so the service can record changes in the audit log.
+func auditor(r *http.Request) context.Context {
+  return service.WithAuditor(r.Context(), ent.AuditEvent{
+    Actor: middleware.ClientID(r),
+    Server: serverID(r),
+    RequestID: middleware.ReqID(r),
+  })
+}
+
+//parse an optional RFC 3339 time from the query.
+func queryTime(r *http.Request, name string) (time.Time, error) {
+  v := r.URL.Query().Get(name)
+  if v == "" {
+    return time.Time{}, nil
+  }
+  
+  return time.Parse(time.RFC3339, v)
+}
+
+//GET /admin/audit?steamid=&actor=&from=&to=&limit=
+func (c *controller) GetAudit(w http.ResponseWriter, r *http.Request) {
+  query := r.URL.Query()
+  filter := service.AuditFilter{
+    Steamid: query.Get("steamid"),
+    Actor: query.Get("actor"),
+  }
+  
+  var err error
+  if filter.From, err = queryTime(r, "from"); err != nil {
+    response.BadRequest(w, err)
+    return
+  }
+  if filter.To, err = queryTime(r, "to"); err != nil {
+    response.BadRequest(w, err)
+    return
+  }
+  if v := query.Get("limit"); v != "" {
+    if filter.Limit, err = strconv.Atoi(v); err != nil {
+      response.BadRequest(w, err)
+      return
+    }
+  }
+  
+  events, err := service.New(r.Context()).AuditSearch(filter)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.Error(w, err)
+    return
+  }
+  
+  response.OK(w, events)
+}
@@ -0,0 +1,121 @@
+package controller
+
+import (
+  "strconv"
+  "net/http"
+  
+  "github.com/msrevive/nexus2/response"
+  "github.com/msrevive/nexus2/service"
+  "github.com/msrevive/nexus2/system"
+  "github.com/msrevive/nexus2/ent"
+  "github.com/msrevive/nexus2/middleware"
+  "github.com/msrevive/nexus2/log"
+  
+  "github.com/gorilla/mux"
+  "github.com/goccy/go-json"
+)
+
+//GET ban/{steamid}
+//in this case false means player isn't banned
+func (c *controller) GetBanVerify(w http.ResponseWriter, r *http.Request) {
+  if !system.Config.Verify.EnforceBan {
+    response.Result(w, false)
+    return
+  }
+  
+  vars := mux.Vars(r)
+  steamid := vars["steamid"]
+  
+  banned, err := service.New(r.Context()).BanIsActive(steamid)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.Error(w, err)
+    return
+  }
+  
+  response.Result(w, banned)
+}
+
+//look up steamid in the ban table, a failed lookup is logged and treated as not banned.
+func checkBan(r *http.Request, steamid string) bool {
+  if !system.Config.Verify.EnforceBan {
+    return false
+  }
+  
+  banned, err := service.New(r.Context()).BanIsActive(steamid)
+  if err != nil {
+    log.Log.Errorln(err)
+    return false
+  }
+  
+  return banned
+}
+
+//GET ban/?all=true
+func (c *controller) GetBans(w http.ResponseWriter, r *http.Request) {
+  all, _ := strconv.ParseBool(r.URL.Query().Get("all"))
+  
+  bans, err := service.New(r.Context()).BansGetAll(all)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  response.OK(w, bans)
+}
+
+//GET ban/search?q=
+func (c *controller) SearchBans(w http.ResponseWriter, r *http.Request) {
+  query := r.URL.Query()
+  all, _ := strconv.ParseBool(query.Get("all"))
+  
+  bans, err := service.New(r.Context()).BansSearch(query.Get("q"), all)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  response.OK(w, bans)
+}
+
+//POST ban/
+func (c *controller) PostBan(w http.ResponseWriter, r *http.Request) {
+  var newBan ent.Ban
+  err := json.NewDecoder(r.Body).Decode(&newBan)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  //a ban is issued by whoever authenticated for it, the body only says so when there's no key to go by.
+  if name := middleware.KeyName(r); name != "" {
+    newBan.IssuedBy = name
+  }
+  
+  b, err := service.New(auditor(r)).BanCreate(newBan)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.Error(w, err)
+    return
+  }
+  
+  response.OK(w, b)
+}
+
+//DELETE ban/{steamid}
+func (c *controller) DeleteBan(w http.ResponseWriter, r *http.Request) {
+  vars := mux.Vars(r)
+  steamid := vars["steamid"]
+  
+  n, err := service.New(auditor(r)).BanLift(steamid)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.Error(w, err)
+    return
+  }
+  
+  response.OK(w, n)
+}
@@ -2,6 +2,8 @@ package controller
 
 import (
   "io"
+  "errors"
+  "strings"
   "fmt"
   "strconv"
   "net/http"
@@ -12,6 +14,8 @@ import (
   "github.com/msrevive/nexus2/helper"
   "github.com/msrevive/nexus2/ent"
   "github.com/msrevive/nexus2/log"
+  "github.com/msrevive/nexus2/metrics"
+  "github.com/msrevive/nexus2/charfile"
   
   "github.com/google/uuid"
   "github.com/gorilla/mux"
@@ -44,13 +48,9 @@ func (c *controller) GetCharacters(w http.ResponseWriter, r *http.Request) {
     return
   }
   
-  if _,ok := system.BanList[steamid]; ok && system.Config.Verify.EnforceBan {
-    isBanned = true
-  }
+  isBanned = checkBan(r, steamid)
   
-  if _,ok := system.AdminList[steamid]; ok {
-    isAdmin = true
-  }
+  isAdmin = system.AdminListHas(steamid)
   
   response.OKChar(w, isBanned, isAdmin, chars)
 }
@@ -75,14 +75,11 @@ func (c *controller) GetCharacter(w http.ResponseWriter, r *http.Request) {
     return
   }
   
-  if _,ok := system.BanList[steamid]; ok && system.Config.Verify.EnforceBan {
-    isBanned = true
-  }
+  isBanned = checkBan(r, steamid)
   
-  if _,ok := system.AdminList[steamid]; ok {
-    isAdmin = true
-  }
+  isAdmin = system.AdminListHas(steamid)
   
+  setETag(w, char)
   response.OKChar(w, isBanned, isAdmin, char)
 }
 
@@ -135,15 +132,34 @@ func (c *controller) GetCharacterByID(w http.ResponseWriter, r *http.Request) {
     return
   }
   
-  if _,ok := system.BanList[char.Steamid]; ok && system.Config.Verify.EnforceBan {
-    isBanned = true
+  isBanned = checkBan(r, char.Steamid)
+  
+  isAdmin = system.AdminListHas(char.Steamid)
+  
+  setETag(w, char)
+  response.OKChar(w, isBanned, isAdmin, char)
+}
+
+//check character data before it's stored, responding with 422 and what's wrong if it's bad.
+func validData(w http.ResponseWriter, data string, size int) bool {
+  if !system.Config.Character.ValidateData {
+    return true
   }
   
-  if _,ok := system.AdminList[char.Steamid]; ok {
-    isAdmin = true
+  err := charfile.Validate(data, size)
+  if err == nil {
+    return true
   }
   
-  response.OKChar(w, isBanned, isAdmin, char)
+  log.Log.Errorln(err)
+  var verr *charfile.ValidationError
+  if errors.As(err, &verr) {
+    response.Unprocessable(w, err, verr.Errors)
+    return false
+  }
+  
+  response.BadRequest(w, err)
+  return false
 }
 
 //POST /character/
@@ -156,16 +172,48 @@ func (c *controller) PostCharacter(w http.ResponseWriter, r *http.Request) {
     return
   }
   
-  char, err := service.New(r.Context()).CharacterCreate(newChar)
+  metrics.CharacterSize.WithLabelValues("create").Observe(float64(len(newChar.Data)))
+  
+  if !validData(w, newChar.Data, newChar.Size) {
+    return
+  }
+  
+  char, err := service.New(auditor(r)).CharacterCreate(newChar)
   if err != nil {
     log.Log.Errorln(err)
+    if errors.Is(err, service.ErrCharacterExists) {
+      response.Conflict(w, err)
+      return
+    }
     response.Error(w, err)
     return
   }
   
+  setETag(w, char)
   response.OK(w, char)
 }
 
+//the version a write expects the character to be at, from If-Match or the version in the body.
+func expectedVersion(r *http.Request, body *int) (int, error) {
+  if match := r.Header.Get("If-Match"); match != "" {
+    if match == "*" {
+      return service.AnyVersion, nil
+    }
+    
+    return strconv.Atoi(strings.Trim(strings.TrimPrefix(match, "W/"), `"`))
+  }
+  
+  if body != nil {
+    return *body, nil
+  }
+  
+  return service.AnyVersion, nil
+}
+
+func setETag(w http.ResponseWriter, char *ent.Character) {
+  w.Header().Set("ETag", fmt.Sprintf(`"%d"`, char.Version))
+}
+
 //PUT /character/{uid}
 func (c *controller) PutCharacter(w http.ResponseWriter, r *http.Request) {
   vars := mux.Vars(r)
@@ -176,7 +224,10 @@ func (c *controller) PutCharacter(w http.ResponseWriter, r *http.Request) {
     return
   }
   
-  var updateChar ent.Character
+  var updateChar struct {
+    ent.Character
+    Version *int `json:"version"`
+  }
   err = json.NewDecoder(r.Body).Decode(&updateChar)
   if err != nil {
     log.Log.Errorln(err)
@@ -184,13 +235,49 @@ func (c *controller) PutCharacter(w http.ResponseWriter, r *http.Request) {
     return
   }
   
-  char, err := service.New(r.Context()).CharacterUpdate(uid, updateChar)
+  version, err := expectedVersion(r, updateChar.Version)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  if version == service.AnyVersion && system.Config.Character.EnforceVersion && r.Header.Get("If-Match") != "*" {
+    response.PreconditionRequired(w, errors.New("an If-Match header or version is required"))
+    return
+  }
+  
+  metrics.CharacterSize.WithLabelValues("update").Observe(float64(len(updateChar.Data)))
+  
+  if !validData(w, updateChar.Data, updateChar.Size) {
+    return
+  }
+  
+  char, err := service.New(auditor(r)).CharacterUpdate(uid, updateChar.Character, version, serverID(r))
   if err != nil {
     log.Log.Errorln(err)
+    if errors.Is(err, service.ErrVersionMismatch) {
+      response.PreconditionFailed(w, err)
+      return
+    }
+    if errors.Is(err, service.ErrCharacterLocked) {
+      response.Locked(w, err)
+      return
+    }
+    var aerr *service.AnomalyError
+    if errors.As(err, &aerr) {
+      if aerr.Action == service.AnomalyQuarantine {
+        response.Accepted(w, err, aerr.Findings)
+        return
+      }
+      response.Unprocessable(w, err, aerr.Findings)
+      return
+    }
     response.Error(w, err)
     return
   }
   
+  setETag(w, char)
   response.OK(w, char)
 }
 
@@ -204,12 +291,71 @@ func (c *controller) DeleteCharacter(w http.ResponseWriter, r *http.Request) {
     return
   }
   
-  err = service.New(r.Context()).CharacterDelete(uid)
+  err = service.New(auditor(r)).CharacterDelete(uid, serverID(r))
   if err != nil {
     log.Log.Errorln(err)
+    if errors.Is(err, service.ErrCharacterLocked) {
+      response.Locked(w, err)
+      return
+    }
     response.Error(w, err)
     return
   }
   
   response.OK(w, uid)
+}
+
+//GET /character/{uid}/versions
+func (c *controller) GetCharacterVersions(w http.ResponseWriter, r *http.Request) {
+  vars := mux.Vars(r)
+  uid, err := uuid.Parse(vars["uid"])
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  versions, err := service.New(r.Context()).CharacterVersions(uid)
+  if err != nil {
+    log.Log.Errorln(err)
+    if ent.IsNotFound(err) {
+      response.NotFound(w, err)
+      return
+    }
+    response.Error(w, err)
+    return
+  }
+  
+  response.OK(w, versions)
+}
+
+//POST /character/{uid}/rollback/{version}
+func (c *controller) RollbackCharacter(w http.ResponseWriter, r *http.Request) {
+  vars := mux.Vars(r)
+  uid, err := uuid.Parse(vars["uid"])
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  version, err := strconv.Atoi(vars["version"])
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  char, err := service.New(auditor(r)).CharacterRollback(uid, version)
+  if err != nil {
+    log.Log.Errorln(err)
+    if ent.IsNotFound(err) {
+      response.NotFound(w, err)
+      return
+    }
+    response.Error(w, err)
+    return
+  }
+  
+  setETag(w, char)
+  response.OK(w, char)
 }
\ No newline at end of file
@@ -0,0 +1,90 @@
+package controller
+
+import (
+  "io"
+  "bytes"
+  "errors"
+  "strconv"
+  "strings"
+  "net/http"
+  "mime/multipart"
+  
+  "github.com/msrevive/nexus2/response"
+  "github.com/msrevive/nexus2/service"
+  "github.com/msrevive/nexus2/helper"
+  "github.com/msrevive/nexus2/log"
+)
+
+//the largest upload the import endpoint accepts.
+const maxImportSize = 256 << 20
+
+//read the uploaded .char files, either as multipart file fields or as a zip body.
+func readImport(r *http.Request) ([]helper.CharFile, error) {
+  if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+    if err := r.ParseMultipartForm(32 << 20); err != nil {
+      return nil, err
+    }
+    defer r.MultipartForm.RemoveAll()
+    
+    return readMultipart(r.MultipartForm)
+  }
+  
+  data, err := io.ReadAll(r.Body)
+  if err != nil {
+    return nil, err
+  }
+  
+  return helper.ReadCharZip(bytes.NewReader(data), int64(len(data)))
+}
+
+func readMultipart(form *multipart.Form) ([]helper.CharFile, error) {
+  var files []helper.CharFile
+  for _,headers := range form.File {
+    for _,fh := range headers {
+      f, err := fh.Open()
+      if err != nil {
+        return nil, err
+      }
+      data, err := io.ReadAll(f)
+      f.Close()
+      if err != nil {
+        return nil, err
+      }
+      
+      files = append(files, helper.CharFile{Name: fh.Filename, Data: data})
+    }
+  }
+  
+  return files, nil
+}
+
+//POST /admin/import?policy=skip&dry_run=true
+func (c *controller) PostImport(w http.ResponseWriter, r *http.Request) {
+  query := r.URL.Query()
+  dryRun, _ := strconv.ParseBool(query.Get("dry_run"))
+  policy := query.Get("policy")
+  if policy == "" {
+    policy = service.ImportSkip
+  }
+  if !service.ValidImportPolicy(policy) {
+    response.BadRequest(w, errors.New("policy must be skip or overwrite"))
+    return
+  }
+  
+  r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+  files, err := readImport(r)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  res, err := service.New(auditor(r)).CharactersImport(files, policy, dryRun)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.Error(w, err)
+    return
+  }
+  
+  response.OK(w, res)
+}
@@ -0,0 +1,165 @@
+package controller
+
+import (
+  "io"
+  "fmt"
+  "time"
+  "errors"
+  "net/http"
+  
+  "github.com/msrevive/nexus2/response"
+  "github.com/msrevive/nexus2/service"
+  "github.com/msrevive/nexus2/system"
+  "github.com/msrevive/nexus2/middleware"
+  "github.com/msrevive/nexus2/log"
+  
+  "github.com/google/uuid"
+  "github.com/gorilla/mux"
+  "github.com/goccy/go-json"
+)
+
+//used when neither the request nor Character.LockTTL set one.
+const defaultLockTTL = 300
+
+//the longest lock a server can ask for when Character.MaxLockTTL isn't set.
+const defaultMaxLockTTL = 3600
+
+type lockRequest struct {
+  Server string `json:"server"`
+  TTL int `json:"ttl"`
+}
+
+//servers are known by the key, certificate or signature they authenticated with. What a server calls
+//itself with the X-Server-ID header is only taken when keys aren't enforced, there's nothing else to go by then.
+func serverID(r *http.Request) string {
+  if name := middleware.KeyName(r); name != "" {
+    return name
+  }
+  if system.Config.ApiAuth.EnforceKey {
+    return ""
+  }
+  
+  return r.Header.Get("X-Server-ID")
+}
+
+//read the server and TTL (in seconds) from a lock request, the body is optional.
+func parseLock(r *http.Request) (string, time.Duration, error) {
+  var req lockRequest
+  if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+    return "", 0, err
+  }
+  
+  server := serverID(r)
+  if server == "" && !system.Config.ApiAuth.EnforceKey {
+    server = req.Server
+  }
+  if server == "" {
+    return "", 0, errors.New("a server identity is required")
+  }
+  
+  if req.TTL <= 0 {
+    req.TTL = system.Config.Character.LockTTL
+  }
+  if req.TTL <= 0 {
+    req.TTL = defaultLockTTL
+  }
+  
+  max := system.Config.Character.MaxLockTTL
+  if max <= 0 {
+    max = defaultMaxLockTTL
+  }
+  if req.TTL > max {
+    return "", 0, fmt.Errorf("ttl can't be more than %d seconds", max)
+  }
+  
+  return server, time.Duration(req.TTL) * time.Second, nil
+}
+
+func lockError(w http.ResponseWriter, err error) {
+  if errors.Is(err, service.ErrCharacterLocked) || errors.Is(err, service.ErrLockNotHeld) {
+    response.Conflict(w, err)
+    return
+  }
+  
+  response.Error(w, err)
+}
+
+//POST /character/{uid}/lock
+func (c *controller) LockCharacter(w http.ResponseWriter, r *http.Request) {
+  vars := mux.Vars(r)
+  uid, err := uuid.Parse(vars["uid"])
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  server, ttl, err := parseLock(r)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  lock, err := service.New(auditor(r)).CharacterLock(uid, server, ttl)
+  if err != nil {
+    log.Log.Errorln(err)
+    lockError(w, err)
+    return
+  }
+  
+  response.OK(w, lock)
+}
+
+//PATCH /character/{uid}/lock
+func (c *controller) RenewCharacterLock(w http.ResponseWriter, r *http.Request) {
+  vars := mux.Vars(r)
+  uid, err := uuid.Parse(vars["uid"])
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  server, ttl, err := parseLock(r)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  lock, err := service.New(auditor(r)).CharacterLockRenew(uid, server, ttl)
+  if err != nil {
+    log.Log.Errorln(err)
+    lockError(w, err)
+    return
+  }
+  
+  response.OK(w, lock)
+}
+
+//DELETE /character/{uid}/lock
+func (c *controller) UnlockCharacter(w http.ResponseWriter, r *http.Request) {
+  vars := mux.Vars(r)
+  uid, err := uuid.Parse(vars["uid"])
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  server, _, err := parseLock(r)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  if err := service.New(auditor(r)).CharacterUnlock(uid, server); err != nil {
+    log.Log.Errorln(err)
+    lockError(w, err)
+    return
+  }
+  
+  response.Result(w, true)
+}
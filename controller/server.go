@@ -0,0 +1,127 @@
+package controller
+
+import (
+  "errors"
+  "strconv"
+  "net/http"
+  
+  "github.com/msrevive/nexus2/response"
+  "github.com/msrevive/nexus2/service"
+  "github.com/msrevive/nexus2/system"
+  "github.com/msrevive/nexus2/middleware"
+  "github.com/msrevive/nexus2/ent"
+  "github.com/msrevive/nexus2/log"
+  
+  "github.com/gorilla/mux"
+  "github.com/goccy/go-json"
+)
+
+var errNotOwnServer = errors.New("servers can only register and report as the key they authenticate with")
+
+//a server can only act on the registry entry named after its key, admins can act on any.
+//Without enforced keys there's no identity to check against.
+func ownServer(r *http.Request, name string) bool {
+  if !system.Config.ApiAuth.EnforceKey || middleware.HasScope(r, system.ScopeAdmin) {
+    return true
+  }
+  
+  return name == serverID(r)
+}
+
+func serverError(w http.ResponseWriter, err error) {
+  if errors.Is(err, service.ErrServerNotRegistered) {
+    response.NotFound(w, err)
+    return
+  }
+  
+  response.Error(w, err)
+}
+
+//GET /servers/?all=true
+func (c *controller) GetServers(w http.ResponseWriter, r *http.Request) {
+  all, _ := strconv.ParseBool(r.URL.Query().Get("all"))
+  
+  srvs, err := service.New(r.Context()).ServersGetAll(all)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.Error(w, err)
+    return
+  }
+  
+  response.OK(w, srvs)
+}
+
+//POST /servers/
+func (c *controller) PostServer(w http.ResponseWriter, r *http.Request) {
+  var newSrv ent.Server
+  err := json.NewDecoder(r.Body).Decode(&newSrv)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  if newSrv.Name == "" {
+    newSrv.Name = serverID(r)
+  }
+  if newSrv.Name == "" {
+    response.BadRequest(w, errors.New("a server name is required"))
+    return
+  }
+  if !ownServer(r, newSrv.Name) {
+    response.Forbidden(w, errNotOwnServer)
+    return
+  }
+  
+  srv, err := service.New(r.Context()).ServerRegister(newSrv)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.Error(w, err)
+    return
+  }
+  
+  response.OK(w, srv)
+}
+
+//PUT /servers/{name}/heartbeat
+func (c *controller) PutServerHeartbeat(w http.ResponseWriter, r *http.Request) {
+  vars := mux.Vars(r)
+  if !ownServer(r, vars["name"]) {
+    response.Forbidden(w, errNotOwnServer)
+    return
+  }
+  
+  var beat ent.Server
+  err := json.NewDecoder(r.Body).Decode(&beat)
+  if err != nil {
+    log.Log.Errorln(err)
+    response.BadRequest(w, err)
+    return
+  }
+  
+  srv, err := service.New(r.Context()).ServerHeartbeat(vars["name"], beat)
+  if err != nil {
+    log.Log.Errorln(err)
+    serverError(w, err)
+    return
+  }
+  
+  response.OK(w, srv)
+}
+
+//DELETE /servers/{name}
+func (c *controller) DeleteServer(w http.ResponseWriter, r *http.Request) {
+  vars := mux.Vars(r)
+  if !ownServer(r, vars["name"]) {
+    response.Forbidden(w, errNotOwnServer)
+    return
+  }
+  
+  if err := service.New(r.Context()).ServerOffline(vars["name"]); err != nil {
+    log.Log.Errorln(err)
+    serverError(w, err)
+    return
+  }
+  
+  response.Result(w, true)
+}
@@ -0,0 +1,223 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/anomaly"
+)
+
+// Anomaly is the model entity for the Anomaly schema.
+type Anomaly struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CharacterID holds the value of the "character_id" field.
+	CharacterID uuid.UUID `json:"character_id,omitempty"`
+	// Steamid holds the value of the "steamid" field.
+	Steamid string `json:"steamid,omitempty"`
+	// Server holds the value of the "server" field.
+	Server string `json:"server,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// Findings holds the value of the "findings" field.
+	Findings []string `json:"findings,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version"`
+	// Size holds the value of the "size" field.
+	Size int `json:"size,omitempty"`
+	// Data holds the value of the "data" field.
+	Data string `json:"data,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// ReviewedBy holds the value of the "reviewed_by" field.
+	ReviewedBy string `json:"reviewed_by,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Anomaly) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case anomaly.FieldFindings:
+			values[i] = new([]byte)
+		case anomaly.FieldVersion, anomaly.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case anomaly.FieldSteamid, anomaly.FieldServer, anomaly.FieldAction, anomaly.FieldStatus, anomaly.FieldData, anomaly.FieldReviewedBy:
+			values[i] = new(sql.NullString)
+		case anomaly.FieldCreatedAt, anomaly.FieldReviewedAt:
+			values[i] = new(sql.NullTime)
+		case anomaly.FieldID, anomaly.FieldCharacterID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Anomaly", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Anomaly fields.
+func (a *Anomaly) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case anomaly.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				a.ID = *value
+			}
+		case anomaly.FieldCharacterID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field character_id", values[i])
+			} else if value != nil {
+				a.CharacterID = *value
+			}
+		case anomaly.FieldSteamid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field steamid", values[i])
+			} else if value.Valid {
+				a.Steamid = value.String
+			}
+		case anomaly.FieldServer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field server", values[i])
+			} else if value.Valid {
+				a.Server = value.String
+			}
+		case anomaly.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				a.Action = value.String
+			}
+		case anomaly.FieldFindings:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field findings", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.Findings); err != nil {
+					return fmt.Errorf("unmarshal field findings: %w", err)
+				}
+			}
+		case anomaly.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				a.Status = value.String
+			}
+		case anomaly.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				a.Version = int(value.Int64)
+			}
+		case anomaly.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				a.Size = int(value.Int64)
+			}
+		case anomaly.FieldData:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value.Valid {
+				a.Data = value.String
+			}
+		case anomaly.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				a.CreatedAt = value.Time
+			}
+		case anomaly.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				a.ReviewedAt = new(time.Time)
+				*a.ReviewedAt = value.Time
+			}
+		case anomaly.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				a.ReviewedBy = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Anomaly.
+// Note that you need to call Anomaly.Unwrap() before calling this method if this Anomaly
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Anomaly) Update() *AnomalyUpdateOne {
+	return (&AnomalyClient{config: a.config}).UpdateOne(a)
+}
+
+// Unwrap unwraps the Anomaly entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Anomaly) Unwrap() *Anomaly {
+	tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Anomaly is not a transactional entity")
+	}
+	a.config.driver = tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Anomaly) String() string {
+	var builder strings.Builder
+	builder.WriteString("Anomaly(")
+	builder.WriteString(fmt.Sprintf("id=%v", a.ID))
+	builder.WriteString(", character_id=")
+	builder.WriteString(fmt.Sprintf("%v", a.CharacterID))
+	builder.WriteString(", steamid=")
+	builder.WriteString(a.Steamid)
+	builder.WriteString(", server=")
+	builder.WriteString(a.Server)
+	builder.WriteString(", action=")
+	builder.WriteString(a.Action)
+	builder.WriteString(", findings=")
+	builder.WriteString(fmt.Sprintf("%v", a.Findings))
+	builder.WriteString(", status=")
+	builder.WriteString(a.Status)
+	builder.WriteString(", version=")
+	builder.WriteString(fmt.Sprintf("%v", a.Version))
+	builder.WriteString(", size=")
+	builder.WriteString(fmt.Sprintf("%v", a.Size))
+	builder.WriteString(", data=")
+	builder.WriteString(a.Data)
+	builder.WriteString(", created_at=")
+	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
+	if v := a.ReviewedAt; v != nil {
+		builder.WriteString(", reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", reviewed_by=")
+	builder.WriteString(a.ReviewedBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Anomalies is a parsable slice of Anomaly.
+type Anomalies []*Anomaly
+
+func (a Anomalies) config(cfg config) {
+	for _i := range a {
+		a[_i].config = cfg
+	}
+}
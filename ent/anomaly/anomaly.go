@@ -0,0 +1,84 @@
+// Code generated by entc, DO NOT EDIT.
+
+package anomaly
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the anomaly type in the database.
+	Label = "anomaly"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCharacterID holds the string denoting the character_id field in the database.
+	FieldCharacterID = "character_id"
+	// FieldSteamid holds the string denoting the steamid field in the database.
+	FieldSteamid = "steamid"
+	// FieldServer holds the string denoting the server field in the database.
+	FieldServer = "server"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldFindings holds the string denoting the findings field in the database.
+	FieldFindings = "findings"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldData holds the string denoting the data field in the database.
+	FieldData = "data"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldReviewedBy holds the string denoting the reviewed_by field in the database.
+	FieldReviewedBy = "reviewed_by"
+	// Table holds the table name of the anomaly in the database.
+	Table = "anomalies"
+)
+
+// Columns holds all SQL columns for anomaly fields.
+var Columns = []string{
+	FieldID,
+	FieldCharacterID,
+	FieldSteamid,
+	FieldServer,
+	FieldAction,
+	FieldFindings,
+	FieldStatus,
+	FieldVersion,
+	FieldSize,
+	FieldData,
+	FieldCreatedAt,
+	FieldReviewedAt,
+	FieldReviewedBy,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultServer holds the default value on creation for the "server" field.
+	DefaultServer string
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultSize holds the default value on creation for the "size" field.
+	DefaultSize int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultReviewedBy holds the default value on creation for the "reviewed_by" field.
+	DefaultReviewedBy string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
@@ -0,0 +1,1277 @@
+// Code generated by entc, DO NOT EDIT.
+
+package anomaly
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CharacterID applies equality check predicate on the "character_id" field. It's identical to CharacterIDEQ.
+func CharacterID(v uuid.UUID) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCharacterID), v))
+	})
+}
+
+// Steamid applies equality check predicate on the "steamid" field. It's identical to SteamidEQ.
+func Steamid(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSteamid), v))
+	})
+}
+
+// Server applies equality check predicate on the "server" field. It's identical to ServerEQ.
+func Server(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldServer), v))
+	})
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAction), v))
+	})
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVersion), v))
+	})
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSize), v))
+	})
+}
+
+// Data applies equality check predicate on the "data" field. It's identical to DataEQ.
+func Data(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldData), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReviewedAt), v))
+	})
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReviewedBy), v))
+	})
+}
+
+// CharacterIDEQ applies the EQ predicate on the "character_id" field.
+func CharacterIDEQ(v uuid.UUID) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCharacterID), v))
+	})
+}
+
+// CharacterIDNEQ applies the NEQ predicate on the "character_id" field.
+func CharacterIDNEQ(v uuid.UUID) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCharacterID), v))
+	})
+}
+
+// CharacterIDIn applies the In predicate on the "character_id" field.
+func CharacterIDIn(vs ...uuid.UUID) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCharacterID), v...))
+	})
+}
+
+// CharacterIDNotIn applies the NotIn predicate on the "character_id" field.
+func CharacterIDNotIn(vs ...uuid.UUID) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCharacterID), v...))
+	})
+}
+
+// CharacterIDGT applies the GT predicate on the "character_id" field.
+func CharacterIDGT(v uuid.UUID) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCharacterID), v))
+	})
+}
+
+// CharacterIDGTE applies the GTE predicate on the "character_id" field.
+func CharacterIDGTE(v uuid.UUID) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCharacterID), v))
+	})
+}
+
+// CharacterIDLT applies the LT predicate on the "character_id" field.
+func CharacterIDLT(v uuid.UUID) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCharacterID), v))
+	})
+}
+
+// CharacterIDLTE applies the LTE predicate on the "character_id" field.
+func CharacterIDLTE(v uuid.UUID) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCharacterID), v))
+	})
+}
+
+// SteamidEQ applies the EQ predicate on the "steamid" field.
+func SteamidEQ(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidNEQ applies the NEQ predicate on the "steamid" field.
+func SteamidNEQ(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidIn applies the In predicate on the "steamid" field.
+func SteamidIn(vs ...string) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSteamid), v...))
+	})
+}
+
+// SteamidNotIn applies the NotIn predicate on the "steamid" field.
+func SteamidNotIn(vs ...string) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSteamid), v...))
+	})
+}
+
+// SteamidGT applies the GT predicate on the "steamid" field.
+func SteamidGT(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidGTE applies the GTE predicate on the "steamid" field.
+func SteamidGTE(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidLT applies the LT predicate on the "steamid" field.
+func SteamidLT(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidLTE applies the LTE predicate on the "steamid" field.
+func SteamidLTE(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidContains applies the Contains predicate on the "steamid" field.
+func SteamidContains(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidHasPrefix applies the HasPrefix predicate on the "steamid" field.
+func SteamidHasPrefix(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidHasSuffix applies the HasSuffix predicate on the "steamid" field.
+func SteamidHasSuffix(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidEqualFold applies the EqualFold predicate on the "steamid" field.
+func SteamidEqualFold(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidContainsFold applies the ContainsFold predicate on the "steamid" field.
+func SteamidContainsFold(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSteamid), v))
+	})
+}
+
+// ServerEQ applies the EQ predicate on the "server" field.
+func ServerEQ(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldServer), v))
+	})
+}
+
+// ServerNEQ applies the NEQ predicate on the "server" field.
+func ServerNEQ(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldServer), v))
+	})
+}
+
+// ServerIn applies the In predicate on the "server" field.
+func ServerIn(vs ...string) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldServer), v...))
+	})
+}
+
+// ServerNotIn applies the NotIn predicate on the "server" field.
+func ServerNotIn(vs ...string) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldServer), v...))
+	})
+}
+
+// ServerGT applies the GT predicate on the "server" field.
+func ServerGT(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldServer), v))
+	})
+}
+
+// ServerGTE applies the GTE predicate on the "server" field.
+func ServerGTE(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldServer), v))
+	})
+}
+
+// ServerLT applies the LT predicate on the "server" field.
+func ServerLT(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldServer), v))
+	})
+}
+
+// ServerLTE applies the LTE predicate on the "server" field.
+func ServerLTE(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldServer), v))
+	})
+}
+
+// ServerContains applies the Contains predicate on the "server" field.
+func ServerContains(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldServer), v))
+	})
+}
+
+// ServerHasPrefix applies the HasPrefix predicate on the "server" field.
+func ServerHasPrefix(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldServer), v))
+	})
+}
+
+// ServerHasSuffix applies the HasSuffix predicate on the "server" field.
+func ServerHasSuffix(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldServer), v))
+	})
+}
+
+// ServerEqualFold applies the EqualFold predicate on the "server" field.
+func ServerEqualFold(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldServer), v))
+	})
+}
+
+// ServerContainsFold applies the ContainsFold predicate on the "server" field.
+func ServerContainsFold(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldServer), v))
+	})
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAction), v))
+	})
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAction), v))
+	})
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAction), v...))
+	})
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAction), v...))
+	})
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAction), v))
+	})
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAction), v))
+	})
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAction), v))
+	})
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAction), v))
+	})
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAction), v))
+	})
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAction), v))
+	})
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAction), v))
+	})
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAction), v))
+	})
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAction), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStatus), v))
+	})
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVersion), v))
+	})
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldVersion), v))
+	})
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldVersion), v...))
+	})
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldVersion), v...))
+	})
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldVersion), v))
+	})
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldVersion), v))
+	})
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldVersion), v))
+	})
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldVersion), v))
+	})
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSize), v))
+	})
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSize), v))
+	})
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSize), v...))
+	})
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSize), v...))
+	})
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSize), v))
+	})
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSize), v))
+	})
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSize), v))
+	})
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSize), v))
+	})
+}
+
+// DataEQ applies the EQ predicate on the "data" field.
+func DataEQ(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldData), v))
+	})
+}
+
+// DataNEQ applies the NEQ predicate on the "data" field.
+func DataNEQ(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldData), v))
+	})
+}
+
+// DataIn applies the In predicate on the "data" field.
+func DataIn(vs ...string) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldData), v...))
+	})
+}
+
+// DataNotIn applies the NotIn predicate on the "data" field.
+func DataNotIn(vs ...string) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldData), v...))
+	})
+}
+
+// DataGT applies the GT predicate on the "data" field.
+func DataGT(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldData), v))
+	})
+}
+
+// DataGTE applies the GTE predicate on the "data" field.
+func DataGTE(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldData), v))
+	})
+}
+
+// DataLT applies the LT predicate on the "data" field.
+func DataLT(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldData), v))
+	})
+}
+
+// DataLTE applies the LTE predicate on the "data" field.
+func DataLTE(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldData), v))
+	})
+}
+
+// DataContains applies the Contains predicate on the "data" field.
+func DataContains(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldData), v))
+	})
+}
+
+// DataHasPrefix applies the HasPrefix predicate on the "data" field.
+func DataHasPrefix(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldData), v))
+	})
+}
+
+// DataHasSuffix applies the HasSuffix predicate on the "data" field.
+func DataHasSuffix(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldData), v))
+	})
+}
+
+// DataIsNil applies the IsNil predicate on the "data" field.
+func DataIsNil() predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldData)))
+	})
+}
+
+// DataNotNil applies the NotNil predicate on the "data" field.
+func DataNotNil() predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldData)))
+	})
+}
+
+// DataEqualFold applies the EqualFold predicate on the "data" field.
+func DataEqualFold(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldData), v))
+	})
+}
+
+// DataContainsFold applies the ContainsFold predicate on the "data" field.
+func DataContainsFold(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldData), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReviewedAt), v))
+	})
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReviewedAt), v))
+	})
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReviewedAt), v...))
+	})
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReviewedAt), v...))
+	})
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReviewedAt), v))
+	})
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReviewedAt), v))
+	})
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReviewedAt), v))
+	})
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReviewedAt), v))
+	})
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldReviewedAt)))
+	})
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldReviewedAt)))
+	})
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReviewedBy), v))
+	})
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReviewedBy), v))
+	})
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...string) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReviewedBy), v...))
+	})
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...string) predicate.Anomaly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Anomaly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReviewedBy), v...))
+	})
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReviewedBy), v))
+	})
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReviewedBy), v))
+	})
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReviewedBy), v))
+	})
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReviewedBy), v))
+	})
+}
+
+// ReviewedByContains applies the Contains predicate on the "reviewed_by" field.
+func ReviewedByContains(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldReviewedBy), v))
+	})
+}
+
+// ReviewedByHasPrefix applies the HasPrefix predicate on the "reviewed_by" field.
+func ReviewedByHasPrefix(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldReviewedBy), v))
+	})
+}
+
+// ReviewedByHasSuffix applies the HasSuffix predicate on the "reviewed_by" field.
+func ReviewedByHasSuffix(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldReviewedBy), v))
+	})
+}
+
+// ReviewedByEqualFold applies the EqualFold predicate on the "reviewed_by" field.
+func ReviewedByEqualFold(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldReviewedBy), v))
+	})
+}
+
+// ReviewedByContainsFold applies the ContainsFold predicate on the "reviewed_by" field.
+func ReviewedByContainsFold(v string) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldReviewedBy), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Anomaly) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Anomaly) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Anomaly) predicate.Anomaly {
+	return predicate.Anomaly(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
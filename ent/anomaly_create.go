@@ -0,0 +1,508 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/anomaly"
+)
+
+// AnomalyCreate is the builder for creating a Anomaly entity.
+type AnomalyCreate struct {
+	config
+	mutation *AnomalyMutation
+	hooks    []Hook
+}
+
+// SetCharacterID sets the "character_id" field.
+func (ac *AnomalyCreate) SetCharacterID(u uuid.UUID) *AnomalyCreate {
+	ac.mutation.SetCharacterID(u)
+	return ac
+}
+
+// SetSteamid sets the "steamid" field.
+func (ac *AnomalyCreate) SetSteamid(s string) *AnomalyCreate {
+	ac.mutation.SetSteamid(s)
+	return ac
+}
+
+// SetServer sets the "server" field.
+func (ac *AnomalyCreate) SetServer(s string) *AnomalyCreate {
+	ac.mutation.SetServer(s)
+	return ac
+}
+
+// SetNillableServer sets the "server" field if the given value is not nil.
+func (ac *AnomalyCreate) SetNillableServer(s *string) *AnomalyCreate {
+	if s != nil {
+		ac.SetServer(*s)
+	}
+	return ac
+}
+
+// SetAction sets the "action" field.
+func (ac *AnomalyCreate) SetAction(s string) *AnomalyCreate {
+	ac.mutation.SetAction(s)
+	return ac
+}
+
+// SetFindings sets the "findings" field.
+func (ac *AnomalyCreate) SetFindings(s []string) *AnomalyCreate {
+	ac.mutation.SetFindings(s)
+	return ac
+}
+
+// SetStatus sets the "status" field.
+func (ac *AnomalyCreate) SetStatus(s string) *AnomalyCreate {
+	ac.mutation.SetStatus(s)
+	return ac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ac *AnomalyCreate) SetNillableStatus(s *string) *AnomalyCreate {
+	if s != nil {
+		ac.SetStatus(*s)
+	}
+	return ac
+}
+
+// SetVersion sets the "version" field.
+func (ac *AnomalyCreate) SetVersion(i int) *AnomalyCreate {
+	ac.mutation.SetVersion(i)
+	return ac
+}
+
+// SetSize sets the "size" field.
+func (ac *AnomalyCreate) SetSize(i int) *AnomalyCreate {
+	ac.mutation.SetSize(i)
+	return ac
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (ac *AnomalyCreate) SetNillableSize(i *int) *AnomalyCreate {
+	if i != nil {
+		ac.SetSize(*i)
+	}
+	return ac
+}
+
+// SetData sets the "data" field.
+func (ac *AnomalyCreate) SetData(s string) *AnomalyCreate {
+	ac.mutation.SetData(s)
+	return ac
+}
+
+// SetNillableData sets the "data" field if the given value is not nil.
+func (ac *AnomalyCreate) SetNillableData(s *string) *AnomalyCreate {
+	if s != nil {
+		ac.SetData(*s)
+	}
+	return ac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ac *AnomalyCreate) SetCreatedAt(t time.Time) *AnomalyCreate {
+	ac.mutation.SetCreatedAt(t)
+	return ac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ac *AnomalyCreate) SetNillableCreatedAt(t *time.Time) *AnomalyCreate {
+	if t != nil {
+		ac.SetCreatedAt(*t)
+	}
+	return ac
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (ac *AnomalyCreate) SetReviewedAt(t time.Time) *AnomalyCreate {
+	ac.mutation.SetReviewedAt(t)
+	return ac
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (ac *AnomalyCreate) SetNillableReviewedAt(t *time.Time) *AnomalyCreate {
+	if t != nil {
+		ac.SetReviewedAt(*t)
+	}
+	return ac
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (ac *AnomalyCreate) SetReviewedBy(s string) *AnomalyCreate {
+	ac.mutation.SetReviewedBy(s)
+	return ac
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (ac *AnomalyCreate) SetNillableReviewedBy(s *string) *AnomalyCreate {
+	if s != nil {
+		ac.SetReviewedBy(*s)
+	}
+	return ac
+}
+
+// SetID sets the "id" field.
+func (ac *AnomalyCreate) SetID(u uuid.UUID) *AnomalyCreate {
+	ac.mutation.SetID(u)
+	return ac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ac *AnomalyCreate) SetNillableID(u *uuid.UUID) *AnomalyCreate {
+	if u != nil {
+		ac.SetID(*u)
+	}
+	return ac
+}
+
+// Mutation returns the AnomalyMutation object of the builder.
+func (ac *AnomalyCreate) Mutation() *AnomalyMutation {
+	return ac.mutation
+}
+
+// Save creates the Anomaly in the database.
+func (ac *AnomalyCreate) Save(ctx context.Context) (*Anomaly, error) {
+	var (
+		err  error
+		node *Anomaly
+	)
+	ac.defaults()
+	if len(ac.hooks) == 0 {
+		if err = ac.check(); err != nil {
+			return nil, err
+		}
+		node, err = ac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AnomalyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ac.check(); err != nil {
+				return nil, err
+			}
+			ac.mutation = mutation
+			if node, err = ac.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ac.hooks) - 1; i >= 0; i-- {
+			if ac.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ac.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ac.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AnomalyCreate) SaveX(ctx context.Context) *Anomaly {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *AnomalyCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *AnomalyCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *AnomalyCreate) defaults() {
+	if _, ok := ac.mutation.Server(); !ok {
+		v := anomaly.DefaultServer
+		ac.mutation.SetServer(v)
+	}
+	if _, ok := ac.mutation.Status(); !ok {
+		v := anomaly.DefaultStatus
+		ac.mutation.SetStatus(v)
+	}
+	if _, ok := ac.mutation.Size(); !ok {
+		v := anomaly.DefaultSize
+		ac.mutation.SetSize(v)
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		v := anomaly.DefaultCreatedAt()
+		ac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ac.mutation.ReviewedBy(); !ok {
+		v := anomaly.DefaultReviewedBy
+		ac.mutation.SetReviewedBy(v)
+	}
+	if _, ok := ac.mutation.ID(); !ok {
+		v := anomaly.DefaultID()
+		ac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AnomalyCreate) check() error {
+	if _, ok := ac.mutation.CharacterID(); !ok {
+		return &ValidationError{Name: "character_id", err: errors.New(`ent: missing required field "Anomaly.character_id"`)}
+	}
+	if _, ok := ac.mutation.Steamid(); !ok {
+		return &ValidationError{Name: "steamid", err: errors.New(`ent: missing required field "Anomaly.steamid"`)}
+	}
+	if _, ok := ac.mutation.Server(); !ok {
+		return &ValidationError{Name: "server", err: errors.New(`ent: missing required field "Anomaly.server"`)}
+	}
+	if _, ok := ac.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "Anomaly.action"`)}
+	}
+	if _, ok := ac.mutation.Findings(); !ok {
+		return &ValidationError{Name: "findings", err: errors.New(`ent: missing required field "Anomaly.findings"`)}
+	}
+	if _, ok := ac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Anomaly.status"`)}
+	}
+	if _, ok := ac.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Anomaly.version"`)}
+	}
+	if _, ok := ac.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Anomaly.size"`)}
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Anomaly.created_at"`)}
+	}
+	if _, ok := ac.mutation.ReviewedBy(); !ok {
+		return &ValidationError{Name: "reviewed_by", err: errors.New(`ent: missing required field "Anomaly.reviewed_by"`)}
+	}
+	return nil
+}
+
+func (ac *AnomalyCreate) sqlSave(ctx context.Context) (*Anomaly, error) {
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (ac *AnomalyCreate) createSpec() (*Anomaly, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Anomaly{config: ac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: anomaly.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: anomaly.FieldID,
+			},
+		}
+	)
+	if id, ok := ac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ac.mutation.CharacterID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: anomaly.FieldCharacterID,
+		})
+		_node.CharacterID = value
+	}
+	if value, ok := ac.mutation.Steamid(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: anomaly.FieldSteamid,
+		})
+		_node.Steamid = value
+	}
+	if value, ok := ac.mutation.Server(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: anomaly.FieldServer,
+		})
+		_node.Server = value
+	}
+	if value, ok := ac.mutation.Action(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: anomaly.FieldAction,
+		})
+		_node.Action = value
+	}
+	if value, ok := ac.mutation.Findings(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: anomaly.FieldFindings,
+		})
+		_node.Findings = value
+	}
+	if value, ok := ac.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: anomaly.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := ac.mutation.Version(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: anomaly.FieldVersion,
+		})
+		_node.Version = value
+	}
+	if value, ok := ac.mutation.Size(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: anomaly.FieldSize,
+		})
+		_node.Size = value
+	}
+	if value, ok := ac.mutation.Data(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: anomaly.FieldData,
+		})
+		_node.Data = value
+	}
+	if value, ok := ac.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: anomaly.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := ac.mutation.ReviewedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: anomaly.FieldReviewedAt,
+		})
+		_node.ReviewedAt = &value
+	}
+	if value, ok := ac.mutation.ReviewedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: anomaly.FieldReviewedBy,
+		})
+		_node.ReviewedBy = value
+	}
+	return _node, _spec
+}
+
+// AnomalyCreateBulk is the builder for creating many Anomaly entities in bulk.
+type AnomalyCreateBulk struct {
+	config
+	builders []*AnomalyCreate
+}
+
+// Save creates the Anomaly entities in the database.
+func (acb *AnomalyCreateBulk) Save(ctx context.Context) ([]*Anomaly, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Anomaly, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AnomalyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *AnomalyCreateBulk) SaveX(ctx context.Context) []*Anomaly {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *AnomalyCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *AnomalyCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,402 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/msrevive/nexus2/ent/anomaly"
+	"github.com/msrevive/nexus2/ent/predicate"
+)
+
+// AnomalyUpdate is the builder for updating Anomaly entities.
+type AnomalyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AnomalyMutation
+}
+
+// Where appends a list predicates to the AnomalyUpdate builder.
+func (au *AnomalyUpdate) Where(ps ...predicate.Anomaly) *AnomalyUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetStatus sets the "status" field.
+func (au *AnomalyUpdate) SetStatus(s string) *AnomalyUpdate {
+	au.mutation.SetStatus(s)
+	return au
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (au *AnomalyUpdate) SetNillableStatus(s *string) *AnomalyUpdate {
+	if s != nil {
+		au.SetStatus(*s)
+	}
+	return au
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (au *AnomalyUpdate) SetReviewedAt(t time.Time) *AnomalyUpdate {
+	au.mutation.SetReviewedAt(t)
+	return au
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (au *AnomalyUpdate) SetNillableReviewedAt(t *time.Time) *AnomalyUpdate {
+	if t != nil {
+		au.SetReviewedAt(*t)
+	}
+	return au
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (au *AnomalyUpdate) ClearReviewedAt() *AnomalyUpdate {
+	au.mutation.ClearReviewedAt()
+	return au
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (au *AnomalyUpdate) SetReviewedBy(s string) *AnomalyUpdate {
+	au.mutation.SetReviewedBy(s)
+	return au
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (au *AnomalyUpdate) SetNillableReviewedBy(s *string) *AnomalyUpdate {
+	if s != nil {
+		au.SetReviewedBy(*s)
+	}
+	return au
+}
+
+// Mutation returns the AnomalyMutation object of the builder.
+func (au *AnomalyUpdate) Mutation() *AnomalyMutation {
+	return au.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AnomalyUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(au.hooks) == 0 {
+		affected, err = au.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AnomalyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			au.mutation = mutation
+			affected, err = au.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(au.hooks) - 1; i >= 0; i-- {
+			if au.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = au.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, au.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AnomalyUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AnomalyUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AnomalyUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (au *AnomalyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   anomaly.Table,
+			Columns: anomaly.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: anomaly.FieldID,
+			},
+		},
+	}
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: anomaly.FieldStatus,
+		})
+	}
+	if au.mutation.DataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: anomaly.FieldData,
+		})
+	}
+	if value, ok := au.mutation.ReviewedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: anomaly.FieldReviewedAt,
+		})
+	}
+	if au.mutation.ReviewedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: anomaly.FieldReviewedAt,
+		})
+	}
+	if value, ok := au.mutation.ReviewedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: anomaly.FieldReviewedBy,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{anomaly.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AnomalyUpdateOne is the builder for updating a single Anomaly entity.
+type AnomalyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AnomalyMutation
+}
+
+// SetStatus sets the "status" field.
+func (auo *AnomalyUpdateOne) SetStatus(s string) *AnomalyUpdateOne {
+	auo.mutation.SetStatus(s)
+	return auo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (auo *AnomalyUpdateOne) SetNillableStatus(s *string) *AnomalyUpdateOne {
+	if s != nil {
+		auo.SetStatus(*s)
+	}
+	return auo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (auo *AnomalyUpdateOne) SetReviewedAt(t time.Time) *AnomalyUpdateOne {
+	auo.mutation.SetReviewedAt(t)
+	return auo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (auo *AnomalyUpdateOne) SetNillableReviewedAt(t *time.Time) *AnomalyUpdateOne {
+	if t != nil {
+		auo.SetReviewedAt(*t)
+	}
+	return auo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (auo *AnomalyUpdateOne) ClearReviewedAt() *AnomalyUpdateOne {
+	auo.mutation.ClearReviewedAt()
+	return auo
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (auo *AnomalyUpdateOne) SetReviewedBy(s string) *AnomalyUpdateOne {
+	auo.mutation.SetReviewedBy(s)
+	return auo
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (auo *AnomalyUpdateOne) SetNillableReviewedBy(s *string) *AnomalyUpdateOne {
+	if s != nil {
+		auo.SetReviewedBy(*s)
+	}
+	return auo
+}
+
+// Mutation returns the AnomalyMutation object of the builder.
+func (auo *AnomalyUpdateOne) Mutation() *AnomalyMutation {
+	return auo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AnomalyUpdateOne) Select(field string, fields ...string) *AnomalyUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Anomaly entity.
+func (auo *AnomalyUpdateOne) Save(ctx context.Context) (*Anomaly, error) {
+	var (
+		err  error
+		node *Anomaly
+	)
+	if len(auo.hooks) == 0 {
+		node, err = auo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AnomalyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			auo.mutation = mutation
+			node, err = auo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(auo.hooks) - 1; i >= 0; i-- {
+			if auo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = auo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, auo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AnomalyUpdateOne) SaveX(ctx context.Context) *Anomaly {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AnomalyUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AnomalyUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (auo *AnomalyUpdateOne) sqlSave(ctx context.Context) (_node *Anomaly, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   anomaly.Table,
+			Columns: anomaly.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: anomaly.FieldID,
+			},
+		},
+	}
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Anomaly.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, anomaly.FieldID)
+		for _, f := range fields {
+			if !anomaly.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != anomaly.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: anomaly.FieldStatus,
+		})
+	}
+	if auo.mutation.DataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: anomaly.FieldData,
+		})
+	}
+	if value, ok := auo.mutation.ReviewedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: anomaly.FieldReviewedAt,
+		})
+	}
+	if auo.mutation.ReviewedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: anomaly.FieldReviewedAt,
+		})
+	}
+	if value, ok := auo.mutation.ReviewedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: anomaly.FieldReviewedBy,
+		})
+	}
+	_node = &Anomaly{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{anomaly.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,196 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/auditevent"
+)
+
+// AuditEvent is the model entity for the AuditEvent schema.
+type AuditEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// Server holds the value of the "server" field.
+	Server string `json:"server,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// Steamid holds the value of the "steamid" field.
+	Steamid string `json:"steamid,omitempty"`
+	// CharacterID holds the value of the "character_id" field.
+	CharacterID *uuid.UUID `json:"character_id,omitempty"`
+	// SizeBefore holds the value of the "size_before" field.
+	SizeBefore *int `json:"size_before,omitempty"`
+	// SizeAfter holds the value of the "size_after" field.
+	SizeAfter *int `json:"size_after,omitempty"`
+	// RequestID holds the value of the "request_id" field.
+	RequestID string `json:"request_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditEvent) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditevent.FieldCharacterID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case auditevent.FieldSizeBefore, auditevent.FieldSizeAfter:
+			values[i] = new(sql.NullInt64)
+		case auditevent.FieldActor, auditevent.FieldServer, auditevent.FieldAction, auditevent.FieldSteamid, auditevent.FieldRequestID:
+			values[i] = new(sql.NullString)
+		case auditevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case auditevent.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type AuditEvent", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditEvent fields.
+func (ae *AuditEvent) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ae.ID = *value
+			}
+		case auditevent.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				ae.Actor = value.String
+			}
+		case auditevent.FieldServer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field server", values[i])
+			} else if value.Valid {
+				ae.Server = value.String
+			}
+		case auditevent.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				ae.Action = value.String
+			}
+		case auditevent.FieldSteamid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field steamid", values[i])
+			} else if value.Valid {
+				ae.Steamid = value.String
+			}
+		case auditevent.FieldCharacterID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field character_id", values[i])
+			} else if value.Valid {
+				ae.CharacterID = new(uuid.UUID)
+				*ae.CharacterID = *value.S.(*uuid.UUID)
+			}
+		case auditevent.FieldSizeBefore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size_before", values[i])
+			} else if value.Valid {
+				ae.SizeBefore = new(int)
+				*ae.SizeBefore = int(value.Int64)
+			}
+		case auditevent.FieldSizeAfter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size_after", values[i])
+			} else if value.Valid {
+				ae.SizeAfter = new(int)
+				*ae.SizeAfter = int(value.Int64)
+			}
+		case auditevent.FieldRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				ae.RequestID = value.String
+			}
+		case auditevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ae.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this AuditEvent.
+// Note that you need to call AuditEvent.Unwrap() before calling this method if this AuditEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ae *AuditEvent) Update() *AuditEventUpdateOne {
+	return (&AuditEventClient{config: ae.config}).UpdateOne(ae)
+}
+
+// Unwrap unwraps the AuditEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ae *AuditEvent) Unwrap() *AuditEvent {
+	tx, ok := ae.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditEvent is not a transactional entity")
+	}
+	ae.config.driver = tx.drv
+	return ae
+}
+
+// String implements the fmt.Stringer.
+func (ae *AuditEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v", ae.ID))
+	builder.WriteString(", actor=")
+	builder.WriteString(ae.Actor)
+	builder.WriteString(", server=")
+	builder.WriteString(ae.Server)
+	builder.WriteString(", action=")
+	builder.WriteString(ae.Action)
+	builder.WriteString(", steamid=")
+	builder.WriteString(ae.Steamid)
+	if v := ae.CharacterID; v != nil {
+		builder.WriteString(", character_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	if v := ae.SizeBefore; v != nil {
+		builder.WriteString(", size_before=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	if v := ae.SizeAfter; v != nil {
+		builder.WriteString(", size_after=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", request_id=")
+	builder.WriteString(ae.RequestID)
+	builder.WriteString(", created_at=")
+	builder.WriteString(ae.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditEvents is a parsable slice of AuditEvent.
+type AuditEvents []*AuditEvent
+
+func (ae AuditEvents) config(cfg config) {
+	for _i := range ae {
+		ae[_i].config = cfg
+	}
+}
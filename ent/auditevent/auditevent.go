@@ -0,0 +1,77 @@
+// Code generated by entc, DO NOT EDIT.
+
+package auditevent
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the auditevent type in the database.
+	Label = "audit_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
+	// FieldServer holds the string denoting the server field in the database.
+	FieldServer = "server"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldSteamid holds the string denoting the steamid field in the database.
+	FieldSteamid = "steamid"
+	// FieldCharacterID holds the string denoting the character_id field in the database.
+	FieldCharacterID = "character_id"
+	// FieldSizeBefore holds the string denoting the size_before field in the database.
+	FieldSizeBefore = "size_before"
+	// FieldSizeAfter holds the string denoting the size_after field in the database.
+	FieldSizeAfter = "size_after"
+	// FieldRequestID holds the string denoting the request_id field in the database.
+	FieldRequestID = "request_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the auditevent in the database.
+	Table = "audit_events"
+)
+
+// Columns holds all SQL columns for auditevent fields.
+var Columns = []string{
+	FieldID,
+	FieldActor,
+	FieldServer,
+	FieldAction,
+	FieldSteamid,
+	FieldCharacterID,
+	FieldSizeBefore,
+	FieldSizeAfter,
+	FieldRequestID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultActor holds the default value on creation for the "actor" field.
+	DefaultActor string
+	// DefaultServer holds the default value on creation for the "server" field.
+	DefaultServer string
+	// ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	ActionValidator func(string) error
+	// DefaultSteamid holds the default value on creation for the "steamid" field.
+	DefaultSteamid string
+	// DefaultRequestID holds the default value on creation for the "request_id" field.
+	DefaultRequestID string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
@@ -0,0 +1,1090 @@
+// Code generated by entc, DO NOT EDIT.
+
+package auditevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActor), v))
+	})
+}
+
+// Server applies equality check predicate on the "server" field. It's identical to ServerEQ.
+func Server(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldServer), v))
+	})
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAction), v))
+	})
+}
+
+// Steamid applies equality check predicate on the "steamid" field. It's identical to SteamidEQ.
+func Steamid(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSteamid), v))
+	})
+}
+
+// CharacterID applies equality check predicate on the "character_id" field. It's identical to CharacterIDEQ.
+func CharacterID(v uuid.UUID) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCharacterID), v))
+	})
+}
+
+// SizeBefore applies equality check predicate on the "size_before" field. It's identical to SizeBeforeEQ.
+func SizeBefore(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSizeBefore), v))
+	})
+}
+
+// SizeAfter applies equality check predicate on the "size_after" field. It's identical to SizeAfterEQ.
+func SizeAfter(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSizeAfter), v))
+	})
+}
+
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRequestID), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActor), v))
+	})
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldActor), v))
+	})
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldActor), v...))
+	})
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldActor), v...))
+	})
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldActor), v))
+	})
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldActor), v))
+	})
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldActor), v))
+	})
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldActor), v))
+	})
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldActor), v))
+	})
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldActor), v))
+	})
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldActor), v))
+	})
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldActor), v))
+	})
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldActor), v))
+	})
+}
+
+// ServerEQ applies the EQ predicate on the "server" field.
+func ServerEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldServer), v))
+	})
+}
+
+// ServerNEQ applies the NEQ predicate on the "server" field.
+func ServerNEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldServer), v))
+	})
+}
+
+// ServerIn applies the In predicate on the "server" field.
+func ServerIn(vs ...string) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldServer), v...))
+	})
+}
+
+// ServerNotIn applies the NotIn predicate on the "server" field.
+func ServerNotIn(vs ...string) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldServer), v...))
+	})
+}
+
+// ServerGT applies the GT predicate on the "server" field.
+func ServerGT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldServer), v))
+	})
+}
+
+// ServerGTE applies the GTE predicate on the "server" field.
+func ServerGTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldServer), v))
+	})
+}
+
+// ServerLT applies the LT predicate on the "server" field.
+func ServerLT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldServer), v))
+	})
+}
+
+// ServerLTE applies the LTE predicate on the "server" field.
+func ServerLTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldServer), v))
+	})
+}
+
+// ServerContains applies the Contains predicate on the "server" field.
+func ServerContains(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldServer), v))
+	})
+}
+
+// ServerHasPrefix applies the HasPrefix predicate on the "server" field.
+func ServerHasPrefix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldServer), v))
+	})
+}
+
+// ServerHasSuffix applies the HasSuffix predicate on the "server" field.
+func ServerHasSuffix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldServer), v))
+	})
+}
+
+// ServerEqualFold applies the EqualFold predicate on the "server" field.
+func ServerEqualFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldServer), v))
+	})
+}
+
+// ServerContainsFold applies the ContainsFold predicate on the "server" field.
+func ServerContainsFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldServer), v))
+	})
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAction), v))
+	})
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAction), v))
+	})
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAction), v...))
+	})
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAction), v...))
+	})
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAction), v))
+	})
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAction), v))
+	})
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAction), v))
+	})
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAction), v))
+	})
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAction), v))
+	})
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAction), v))
+	})
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAction), v))
+	})
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAction), v))
+	})
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAction), v))
+	})
+}
+
+// SteamidEQ applies the EQ predicate on the "steamid" field.
+func SteamidEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidNEQ applies the NEQ predicate on the "steamid" field.
+func SteamidNEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidIn applies the In predicate on the "steamid" field.
+func SteamidIn(vs ...string) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSteamid), v...))
+	})
+}
+
+// SteamidNotIn applies the NotIn predicate on the "steamid" field.
+func SteamidNotIn(vs ...string) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSteamid), v...))
+	})
+}
+
+// SteamidGT applies the GT predicate on the "steamid" field.
+func SteamidGT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidGTE applies the GTE predicate on the "steamid" field.
+func SteamidGTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidLT applies the LT predicate on the "steamid" field.
+func SteamidLT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidLTE applies the LTE predicate on the "steamid" field.
+func SteamidLTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidContains applies the Contains predicate on the "steamid" field.
+func SteamidContains(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidHasPrefix applies the HasPrefix predicate on the "steamid" field.
+func SteamidHasPrefix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidHasSuffix applies the HasSuffix predicate on the "steamid" field.
+func SteamidHasSuffix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidEqualFold applies the EqualFold predicate on the "steamid" field.
+func SteamidEqualFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidContainsFold applies the ContainsFold predicate on the "steamid" field.
+func SteamidContainsFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSteamid), v))
+	})
+}
+
+// CharacterIDEQ applies the EQ predicate on the "character_id" field.
+func CharacterIDEQ(v uuid.UUID) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCharacterID), v))
+	})
+}
+
+// CharacterIDNEQ applies the NEQ predicate on the "character_id" field.
+func CharacterIDNEQ(v uuid.UUID) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCharacterID), v))
+	})
+}
+
+// CharacterIDIn applies the In predicate on the "character_id" field.
+func CharacterIDIn(vs ...uuid.UUID) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCharacterID), v...))
+	})
+}
+
+// CharacterIDNotIn applies the NotIn predicate on the "character_id" field.
+func CharacterIDNotIn(vs ...uuid.UUID) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCharacterID), v...))
+	})
+}
+
+// CharacterIDGT applies the GT predicate on the "character_id" field.
+func CharacterIDGT(v uuid.UUID) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCharacterID), v))
+	})
+}
+
+// CharacterIDGTE applies the GTE predicate on the "character_id" field.
+func CharacterIDGTE(v uuid.UUID) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCharacterID), v))
+	})
+}
+
+// CharacterIDLT applies the LT predicate on the "character_id" field.
+func CharacterIDLT(v uuid.UUID) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCharacterID), v))
+	})
+}
+
+// CharacterIDLTE applies the LTE predicate on the "character_id" field.
+func CharacterIDLTE(v uuid.UUID) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCharacterID), v))
+	})
+}
+
+// CharacterIDIsNil applies the IsNil predicate on the "character_id" field.
+func CharacterIDIsNil() predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCharacterID)))
+	})
+}
+
+// CharacterIDNotNil applies the NotNil predicate on the "character_id" field.
+func CharacterIDNotNil() predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCharacterID)))
+	})
+}
+
+// SizeBeforeEQ applies the EQ predicate on the "size_before" field.
+func SizeBeforeEQ(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSizeBefore), v))
+	})
+}
+
+// SizeBeforeNEQ applies the NEQ predicate on the "size_before" field.
+func SizeBeforeNEQ(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSizeBefore), v))
+	})
+}
+
+// SizeBeforeIn applies the In predicate on the "size_before" field.
+func SizeBeforeIn(vs ...int) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSizeBefore), v...))
+	})
+}
+
+// SizeBeforeNotIn applies the NotIn predicate on the "size_before" field.
+func SizeBeforeNotIn(vs ...int) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSizeBefore), v...))
+	})
+}
+
+// SizeBeforeGT applies the GT predicate on the "size_before" field.
+func SizeBeforeGT(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSizeBefore), v))
+	})
+}
+
+// SizeBeforeGTE applies the GTE predicate on the "size_before" field.
+func SizeBeforeGTE(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSizeBefore), v))
+	})
+}
+
+// SizeBeforeLT applies the LT predicate on the "size_before" field.
+func SizeBeforeLT(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSizeBefore), v))
+	})
+}
+
+// SizeBeforeLTE applies the LTE predicate on the "size_before" field.
+func SizeBeforeLTE(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSizeBefore), v))
+	})
+}
+
+// SizeBeforeIsNil applies the IsNil predicate on the "size_before" field.
+func SizeBeforeIsNil() predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSizeBefore)))
+	})
+}
+
+// SizeBeforeNotNil applies the NotNil predicate on the "size_before" field.
+func SizeBeforeNotNil() predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSizeBefore)))
+	})
+}
+
+// SizeAfterEQ applies the EQ predicate on the "size_after" field.
+func SizeAfterEQ(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSizeAfter), v))
+	})
+}
+
+// SizeAfterNEQ applies the NEQ predicate on the "size_after" field.
+func SizeAfterNEQ(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSizeAfter), v))
+	})
+}
+
+// SizeAfterIn applies the In predicate on the "size_after" field.
+func SizeAfterIn(vs ...int) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSizeAfter), v...))
+	})
+}
+
+// SizeAfterNotIn applies the NotIn predicate on the "size_after" field.
+func SizeAfterNotIn(vs ...int) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSizeAfter), v...))
+	})
+}
+
+// SizeAfterGT applies the GT predicate on the "size_after" field.
+func SizeAfterGT(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSizeAfter), v))
+	})
+}
+
+// SizeAfterGTE applies the GTE predicate on the "size_after" field.
+func SizeAfterGTE(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSizeAfter), v))
+	})
+}
+
+// SizeAfterLT applies the LT predicate on the "size_after" field.
+func SizeAfterLT(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSizeAfter), v))
+	})
+}
+
+// SizeAfterLTE applies the LTE predicate on the "size_after" field.
+func SizeAfterLTE(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSizeAfter), v))
+	})
+}
+
+// SizeAfterIsNil applies the IsNil predicate on the "size_after" field.
+func SizeAfterIsNil() predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSizeAfter)))
+	})
+}
+
+// SizeAfterNotNil applies the NotNil predicate on the "size_after" field.
+func SizeAfterNotNil() predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSizeAfter)))
+	})
+}
+
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRequestID), v))
+	})
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRequestID), v))
+	})
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...string) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRequestID), v...))
+	})
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...string) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRequestID), v...))
+	})
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRequestID), v))
+	})
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRequestID), v))
+	})
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRequestID), v))
+	})
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRequestID), v))
+	})
+}
+
+// RequestIDContains applies the Contains predicate on the "request_id" field.
+func RequestIDContains(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldRequestID), v))
+	})
+}
+
+// RequestIDHasPrefix applies the HasPrefix predicate on the "request_id" field.
+func RequestIDHasPrefix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldRequestID), v))
+	})
+}
+
+// RequestIDHasSuffix applies the HasSuffix predicate on the "request_id" field.
+func RequestIDHasSuffix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldRequestID), v))
+	})
+}
+
+// RequestIDEqualFold applies the EqualFold predicate on the "request_id" field.
+func RequestIDEqualFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldRequestID), v))
+	})
+}
+
+// RequestIDContainsFold applies the ContainsFold predicate on the "request_id" field.
+func RequestIDContainsFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldRequestID), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AuditEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditEvent) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditEvent) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditEvent) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,467 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/auditevent"
+)
+
+// AuditEventCreate is the builder for creating a AuditEvent entity.
+type AuditEventCreate struct {
+	config
+	mutation *AuditEventMutation
+	hooks    []Hook
+}
+
+// SetActor sets the "actor" field.
+func (aec *AuditEventCreate) SetActor(s string) *AuditEventCreate {
+	aec.mutation.SetActor(s)
+	return aec
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableActor(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetActor(*s)
+	}
+	return aec
+}
+
+// SetServer sets the "server" field.
+func (aec *AuditEventCreate) SetServer(s string) *AuditEventCreate {
+	aec.mutation.SetServer(s)
+	return aec
+}
+
+// SetNillableServer sets the "server" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableServer(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetServer(*s)
+	}
+	return aec
+}
+
+// SetAction sets the "action" field.
+func (aec *AuditEventCreate) SetAction(s string) *AuditEventCreate {
+	aec.mutation.SetAction(s)
+	return aec
+}
+
+// SetSteamid sets the "steamid" field.
+func (aec *AuditEventCreate) SetSteamid(s string) *AuditEventCreate {
+	aec.mutation.SetSteamid(s)
+	return aec
+}
+
+// SetNillableSteamid sets the "steamid" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableSteamid(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetSteamid(*s)
+	}
+	return aec
+}
+
+// SetCharacterID sets the "character_id" field.
+func (aec *AuditEventCreate) SetCharacterID(u uuid.UUID) *AuditEventCreate {
+	aec.mutation.SetCharacterID(u)
+	return aec
+}
+
+// SetNillableCharacterID sets the "character_id" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableCharacterID(u *uuid.UUID) *AuditEventCreate {
+	if u != nil {
+		aec.SetCharacterID(*u)
+	}
+	return aec
+}
+
+// SetSizeBefore sets the "size_before" field.
+func (aec *AuditEventCreate) SetSizeBefore(i int) *AuditEventCreate {
+	aec.mutation.SetSizeBefore(i)
+	return aec
+}
+
+// SetNillableSizeBefore sets the "size_before" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableSizeBefore(i *int) *AuditEventCreate {
+	if i != nil {
+		aec.SetSizeBefore(*i)
+	}
+	return aec
+}
+
+// SetSizeAfter sets the "size_after" field.
+func (aec *AuditEventCreate) SetSizeAfter(i int) *AuditEventCreate {
+	aec.mutation.SetSizeAfter(i)
+	return aec
+}
+
+// SetNillableSizeAfter sets the "size_after" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableSizeAfter(i *int) *AuditEventCreate {
+	if i != nil {
+		aec.SetSizeAfter(*i)
+	}
+	return aec
+}
+
+// SetRequestID sets the "request_id" field.
+func (aec *AuditEventCreate) SetRequestID(s string) *AuditEventCreate {
+	aec.mutation.SetRequestID(s)
+	return aec
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableRequestID(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetRequestID(*s)
+	}
+	return aec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aec *AuditEventCreate) SetCreatedAt(t time.Time) *AuditEventCreate {
+	aec.mutation.SetCreatedAt(t)
+	return aec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableCreatedAt(t *time.Time) *AuditEventCreate {
+	if t != nil {
+		aec.SetCreatedAt(*t)
+	}
+	return aec
+}
+
+// SetID sets the "id" field.
+func (aec *AuditEventCreate) SetID(u uuid.UUID) *AuditEventCreate {
+	aec.mutation.SetID(u)
+	return aec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableID(u *uuid.UUID) *AuditEventCreate {
+	if u != nil {
+		aec.SetID(*u)
+	}
+	return aec
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aec *AuditEventCreate) Mutation() *AuditEventMutation {
+	return aec.mutation
+}
+
+// Save creates the AuditEvent in the database.
+func (aec *AuditEventCreate) Save(ctx context.Context) (*AuditEvent, error) {
+	var (
+		err  error
+		node *AuditEvent
+	)
+	aec.defaults()
+	if len(aec.hooks) == 0 {
+		if err = aec.check(); err != nil {
+			return nil, err
+		}
+		node, err = aec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = aec.check(); err != nil {
+				return nil, err
+			}
+			aec.mutation = mutation
+			if node, err = aec.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(aec.hooks) - 1; i >= 0; i-- {
+			if aec.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = aec.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, aec.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AuditEventCreate) SaveX(ctx context.Context) *AuditEvent {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *AuditEventCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *AuditEventCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aec *AuditEventCreate) defaults() {
+	if _, ok := aec.mutation.Actor(); !ok {
+		v := auditevent.DefaultActor
+		aec.mutation.SetActor(v)
+	}
+	if _, ok := aec.mutation.Server(); !ok {
+		v := auditevent.DefaultServer
+		aec.mutation.SetServer(v)
+	}
+	if _, ok := aec.mutation.Steamid(); !ok {
+		v := auditevent.DefaultSteamid
+		aec.mutation.SetSteamid(v)
+	}
+	if _, ok := aec.mutation.RequestID(); !ok {
+		v := auditevent.DefaultRequestID
+		aec.mutation.SetRequestID(v)
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		v := auditevent.DefaultCreatedAt()
+		aec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := aec.mutation.ID(); !ok {
+		v := auditevent.DefaultID()
+		aec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AuditEventCreate) check() error {
+	if _, ok := aec.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "AuditEvent.actor"`)}
+	}
+	if _, ok := aec.mutation.Server(); !ok {
+		return &ValidationError{Name: "server", err: errors.New(`ent: missing required field "AuditEvent.server"`)}
+	}
+	if _, ok := aec.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AuditEvent.action"`)}
+	}
+	if v, ok := aec.mutation.Action(); ok {
+		if err := auditevent.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditEvent.action": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.Steamid(); !ok {
+		return &ValidationError{Name: "steamid", err: errors.New(`ent: missing required field "AuditEvent.steamid"`)}
+	}
+	if _, ok := aec.mutation.RequestID(); !ok {
+		return &ValidationError{Name: "request_id", err: errors.New(`ent: missing required field "AuditEvent.request_id"`)}
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (aec *AuditEventCreate) sqlSave(ctx context.Context) (*AuditEvent, error) {
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (aec *AuditEventCreate) createSpec() (*AuditEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditEvent{config: aec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: auditevent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: auditevent.FieldID,
+			},
+		}
+	)
+	if id, ok := aec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := aec.mutation.Actor(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditevent.FieldActor,
+		})
+		_node.Actor = value
+	}
+	if value, ok := aec.mutation.Server(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditevent.FieldServer,
+		})
+		_node.Server = value
+	}
+	if value, ok := aec.mutation.Action(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditevent.FieldAction,
+		})
+		_node.Action = value
+	}
+	if value, ok := aec.mutation.Steamid(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditevent.FieldSteamid,
+		})
+		_node.Steamid = value
+	}
+	if value, ok := aec.mutation.CharacterID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: auditevent.FieldCharacterID,
+		})
+		_node.CharacterID = &value
+	}
+	if value, ok := aec.mutation.SizeBefore(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditevent.FieldSizeBefore,
+		})
+		_node.SizeBefore = &value
+	}
+	if value, ok := aec.mutation.SizeAfter(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditevent.FieldSizeAfter,
+		})
+		_node.SizeAfter = &value
+	}
+	if value, ok := aec.mutation.RequestID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditevent.FieldRequestID,
+		})
+		_node.RequestID = value
+	}
+	if value, ok := aec.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: auditevent.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuditEventCreateBulk is the builder for creating many AuditEvent entities in bulk.
+type AuditEventCreateBulk struct {
+	config
+	builders []*AuditEventCreate
+}
+
+// Save creates the AuditEvent entities in the database.
+func (aecb *AuditEventCreateBulk) Save(ctx context.Context) ([]*AuditEvent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AuditEvent, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AuditEventCreateBulk) SaveX(ctx context.Context) []*AuditEvent {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *AuditEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *AuditEventCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
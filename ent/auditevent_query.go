@@ -0,0 +1,921 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/auditevent"
+	"github.com/msrevive/nexus2/ent/predicate"
+)
+
+// AuditEventQuery is the builder for querying AuditEvent entities.
+type AuditEventQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.AuditEvent
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AuditEventQuery builder.
+func (aeq *AuditEventQuery) Where(ps ...predicate.AuditEvent) *AuditEventQuery {
+	aeq.predicates = append(aeq.predicates, ps...)
+	return aeq
+}
+
+// Limit adds a limit step to the query.
+func (aeq *AuditEventQuery) Limit(limit int) *AuditEventQuery {
+	aeq.limit = &limit
+	return aeq
+}
+
+// Offset adds an offset step to the query.
+func (aeq *AuditEventQuery) Offset(offset int) *AuditEventQuery {
+	aeq.offset = &offset
+	return aeq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (aeq *AuditEventQuery) Unique(unique bool) *AuditEventQuery {
+	aeq.unique = &unique
+	return aeq
+}
+
+// Order adds an order step to the query.
+func (aeq *AuditEventQuery) Order(o ...OrderFunc) *AuditEventQuery {
+	aeq.order = append(aeq.order, o...)
+	return aeq
+}
+
+// First returns the first AuditEvent entity from the query.
+// Returns a *NotFoundError when no AuditEvent was found.
+func (aeq *AuditEventQuery) First(ctx context.Context) (*AuditEvent, error) {
+	nodes, err := aeq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{auditevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (aeq *AuditEventQuery) FirstX(ctx context.Context) *AuditEvent {
+	node, err := aeq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AuditEvent ID from the query.
+// Returns a *NotFoundError when no AuditEvent ID was found.
+func (aeq *AuditEventQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = aeq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{auditevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (aeq *AuditEventQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := aeq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AuditEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AuditEvent entity is found.
+// Returns a *NotFoundError when no AuditEvent entities are found.
+func (aeq *AuditEventQuery) Only(ctx context.Context) (*AuditEvent, error) {
+	nodes, err := aeq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{auditevent.Label}
+	default:
+		return nil, &NotSingularError{auditevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (aeq *AuditEventQuery) OnlyX(ctx context.Context) *AuditEvent {
+	node, err := aeq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AuditEvent ID in the query.
+// Returns a *NotSingularError when more than one AuditEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (aeq *AuditEventQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = aeq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{auditevent.Label}
+	default:
+		err = &NotSingularError{auditevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (aeq *AuditEventQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := aeq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AuditEvents.
+func (aeq *AuditEventQuery) All(ctx context.Context) ([]*AuditEvent, error) {
+	if err := aeq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return aeq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (aeq *AuditEventQuery) AllX(ctx context.Context) []*AuditEvent {
+	nodes, err := aeq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AuditEvent IDs.
+func (aeq *AuditEventQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := aeq.Select(auditevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (aeq *AuditEventQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := aeq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (aeq *AuditEventQuery) Count(ctx context.Context) (int, error) {
+	if err := aeq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return aeq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (aeq *AuditEventQuery) CountX(ctx context.Context) int {
+	count, err := aeq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (aeq *AuditEventQuery) Exist(ctx context.Context) (bool, error) {
+	if err := aeq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return aeq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (aeq *AuditEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := aeq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AuditEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (aeq *AuditEventQuery) Clone() *AuditEventQuery {
+	if aeq == nil {
+		return nil
+	}
+	return &AuditEventQuery{
+		config:     aeq.config,
+		limit:      aeq.limit,
+		offset:     aeq.offset,
+		order:      append([]OrderFunc{}, aeq.order...),
+		predicates: append([]predicate.AuditEvent{}, aeq.predicates...),
+		// clone intermediate query.
+		sql:  aeq.sql.Clone(),
+		path: aeq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Actor string `json:"actor,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AuditEvent.Query().
+//		GroupBy(auditevent.FieldActor).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (aeq *AuditEventQuery) GroupBy(field string, fields ...string) *AuditEventGroupBy {
+	group := &AuditEventGroupBy{config: aeq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := aeq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return aeq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Actor string `json:"actor,omitempty"`
+//	}
+//
+//	client.AuditEvent.Query().
+//		Select(auditevent.FieldActor).
+//		Scan(ctx, &v)
+//
+func (aeq *AuditEventQuery) Select(fields ...string) *AuditEventSelect {
+	aeq.fields = append(aeq.fields, fields...)
+	return &AuditEventSelect{AuditEventQuery: aeq}
+}
+
+func (aeq *AuditEventQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range aeq.fields {
+		if !auditevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if aeq.path != nil {
+		prev, err := aeq.path(ctx)
+		if err != nil {
+			return err
+		}
+		aeq.sql = prev
+	}
+	return nil
+}
+
+func (aeq *AuditEventQuery) sqlAll(ctx context.Context) ([]*AuditEvent, error) {
+	var (
+		nodes = []*AuditEvent{}
+		_spec = aeq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &AuditEvent{config: aeq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, aeq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (aeq *AuditEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := aeq.querySpec()
+	_spec.Node.Columns = aeq.fields
+	if len(aeq.fields) > 0 {
+		_spec.Unique = aeq.unique != nil && *aeq.unique
+	}
+	return sqlgraph.CountNodes(ctx, aeq.driver, _spec)
+}
+
+func (aeq *AuditEventQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := aeq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (aeq *AuditEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   auditevent.Table,
+			Columns: auditevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: auditevent.FieldID,
+			},
+		},
+		From:   aeq.sql,
+		Unique: true,
+	}
+	if unique := aeq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := aeq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditevent.FieldID)
+		for i := range fields {
+			if fields[i] != auditevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := aeq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := aeq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := aeq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := aeq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (aeq *AuditEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(aeq.driver.Dialect())
+	t1 := builder.Table(auditevent.Table)
+	columns := aeq.fields
+	if len(columns) == 0 {
+		columns = auditevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if aeq.sql != nil {
+		selector = aeq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if aeq.unique != nil && *aeq.unique {
+		selector.Distinct()
+	}
+	for _, p := range aeq.predicates {
+		p(selector)
+	}
+	for _, p := range aeq.order {
+		p(selector)
+	}
+	if offset := aeq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := aeq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// AuditEventGroupBy is the group-by builder for AuditEvent entities.
+type AuditEventGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (aegb *AuditEventGroupBy) Aggregate(fns ...AggregateFunc) *AuditEventGroupBy {
+	aegb.fns = append(aegb.fns, fns...)
+	return aegb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (aegb *AuditEventGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := aegb.path(ctx)
+	if err != nil {
+		return err
+	}
+	aegb.sql = query
+	return aegb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (aegb *AuditEventGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := aegb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (aegb *AuditEventGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(aegb.fields) > 1 {
+		return nil, errors.New("ent: AuditEventGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := aegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (aegb *AuditEventGroupBy) StringsX(ctx context.Context) []string {
+	v, err := aegb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (aegb *AuditEventGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = aegb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{auditevent.Label}
+	default:
+		err = fmt.Errorf("ent: AuditEventGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (aegb *AuditEventGroupBy) StringX(ctx context.Context) string {
+	v, err := aegb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (aegb *AuditEventGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(aegb.fields) > 1 {
+		return nil, errors.New("ent: AuditEventGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := aegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (aegb *AuditEventGroupBy) IntsX(ctx context.Context) []int {
+	v, err := aegb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (aegb *AuditEventGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = aegb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{auditevent.Label}
+	default:
+		err = fmt.Errorf("ent: AuditEventGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (aegb *AuditEventGroupBy) IntX(ctx context.Context) int {
+	v, err := aegb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (aegb *AuditEventGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(aegb.fields) > 1 {
+		return nil, errors.New("ent: AuditEventGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := aegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (aegb *AuditEventGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := aegb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (aegb *AuditEventGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = aegb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{auditevent.Label}
+	default:
+		err = fmt.Errorf("ent: AuditEventGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (aegb *AuditEventGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := aegb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (aegb *AuditEventGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(aegb.fields) > 1 {
+		return nil, errors.New("ent: AuditEventGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := aegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (aegb *AuditEventGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := aegb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (aegb *AuditEventGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = aegb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{auditevent.Label}
+	default:
+		err = fmt.Errorf("ent: AuditEventGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (aegb *AuditEventGroupBy) BoolX(ctx context.Context) bool {
+	v, err := aegb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (aegb *AuditEventGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range aegb.fields {
+		if !auditevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := aegb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aegb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (aegb *AuditEventGroupBy) sqlQuery() *sql.Selector {
+	selector := aegb.sql.Select()
+	aggregation := make([]string, 0, len(aegb.fns))
+	for _, fn := range aegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(aegb.fields)+len(aegb.fns))
+		for _, f := range aegb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(aegb.fields...)...)
+}
+
+// AuditEventSelect is the builder for selecting fields of AuditEvent entities.
+type AuditEventSelect struct {
+	*AuditEventQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aes *AuditEventSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := aes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	aes.sql = aes.AuditEventQuery.sqlQuery(ctx)
+	return aes.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (aes *AuditEventSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := aes.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (aes *AuditEventSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(aes.fields) > 1 {
+		return nil, errors.New("ent: AuditEventSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := aes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (aes *AuditEventSelect) StringsX(ctx context.Context) []string {
+	v, err := aes.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (aes *AuditEventSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = aes.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{auditevent.Label}
+	default:
+		err = fmt.Errorf("ent: AuditEventSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (aes *AuditEventSelect) StringX(ctx context.Context) string {
+	v, err := aes.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (aes *AuditEventSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(aes.fields) > 1 {
+		return nil, errors.New("ent: AuditEventSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := aes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (aes *AuditEventSelect) IntsX(ctx context.Context) []int {
+	v, err := aes.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (aes *AuditEventSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = aes.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{auditevent.Label}
+	default:
+		err = fmt.Errorf("ent: AuditEventSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (aes *AuditEventSelect) IntX(ctx context.Context) int {
+	v, err := aes.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (aes *AuditEventSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(aes.fields) > 1 {
+		return nil, errors.New("ent: AuditEventSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := aes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (aes *AuditEventSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := aes.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (aes *AuditEventSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = aes.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{auditevent.Label}
+	default:
+		err = fmt.Errorf("ent: AuditEventSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (aes *AuditEventSelect) Float64X(ctx context.Context) float64 {
+	v, err := aes.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (aes *AuditEventSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(aes.fields) > 1 {
+		return nil, errors.New("ent: AuditEventSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := aes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (aes *AuditEventSelect) BoolsX(ctx context.Context) []bool {
+	v, err := aes.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (aes *AuditEventSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = aes.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{auditevent.Label}
+	default:
+		err = fmt.Errorf("ent: AuditEventSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (aes *AuditEventSelect) BoolX(ctx context.Context) bool {
+	v, err := aes.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (aes *AuditEventSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := aes.sql.Query()
+	if err := aes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
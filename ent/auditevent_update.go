@@ -0,0 +1,275 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/msrevive/nexus2/ent/auditevent"
+	"github.com/msrevive/nexus2/ent/predicate"
+)
+
+// AuditEventUpdate is the builder for updating AuditEvent entities.
+type AuditEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditEventMutation
+}
+
+// Where appends a list predicates to the AuditEventUpdate builder.
+func (aeu *AuditEventUpdate) Where(ps ...predicate.AuditEvent) *AuditEventUpdate {
+	aeu.mutation.Where(ps...)
+	return aeu
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aeu *AuditEventUpdate) Mutation() *AuditEventMutation {
+	return aeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aeu *AuditEventUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(aeu.hooks) == 0 {
+		affected, err = aeu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			aeu.mutation = mutation
+			affected, err = aeu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(aeu.hooks) - 1; i >= 0; i-- {
+			if aeu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = aeu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, aeu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeu *AuditEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := aeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aeu *AuditEventUpdate) Exec(ctx context.Context) error {
+	_, err := aeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeu *AuditEventUpdate) ExecX(ctx context.Context) {
+	if err := aeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (aeu *AuditEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   auditevent.Table,
+			Columns: auditevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: auditevent.FieldID,
+			},
+		},
+	}
+	if ps := aeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if aeu.mutation.CharacterIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: auditevent.FieldCharacterID,
+		})
+	}
+	if aeu.mutation.SizeBeforeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: auditevent.FieldSizeBefore,
+		})
+	}
+	if aeu.mutation.SizeAfterCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: auditevent.FieldSizeAfter,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AuditEventUpdateOne is the builder for updating a single AuditEvent entity.
+type AuditEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditEventMutation
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aeuo *AuditEventUpdateOne) Mutation() *AuditEventMutation {
+	return aeuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aeuo *AuditEventUpdateOne) Select(field string, fields ...string) *AuditEventUpdateOne {
+	aeuo.fields = append([]string{field}, fields...)
+	return aeuo
+}
+
+// Save executes the query and returns the updated AuditEvent entity.
+func (aeuo *AuditEventUpdateOne) Save(ctx context.Context) (*AuditEvent, error) {
+	var (
+		err  error
+		node *AuditEvent
+	)
+	if len(aeuo.hooks) == 0 {
+		node, err = aeuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			aeuo.mutation = mutation
+			node, err = aeuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(aeuo.hooks) - 1; i >= 0; i-- {
+			if aeuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = aeuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, aeuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeuo *AuditEventUpdateOne) SaveX(ctx context.Context) *AuditEvent {
+	node, err := aeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aeuo *AuditEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := aeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeuo *AuditEventUpdateOne) ExecX(ctx context.Context) {
+	if err := aeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (aeuo *AuditEventUpdateOne) sqlSave(ctx context.Context) (_node *AuditEvent, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   auditevent.Table,
+			Columns: auditevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: auditevent.FieldID,
+			},
+		},
+	}
+	id, ok := aeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditevent.FieldID)
+		for _, f := range fields {
+			if !auditevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if aeuo.mutation.CharacterIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: auditevent.FieldCharacterID,
+		})
+	}
+	if aeuo.mutation.SizeBeforeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: auditevent.FieldSizeBefore,
+		})
+	}
+	if aeuo.mutation.SizeAfterCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: auditevent.FieldSizeAfter,
+		})
+	}
+	_node = &AuditEvent{config: aeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
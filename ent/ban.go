@@ -0,0 +1,146 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/ban"
+)
+
+// Ban is the model entity for the Ban schema.
+type Ban struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Steamid holds the value of the "steamid" field.
+	Steamid string `json:"steamid,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// IssuedBy holds the value of the "issued_by" field.
+	IssuedBy string `json:"issued_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Ban) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ban.FieldSteamid, ban.FieldReason, ban.FieldIssuedBy:
+			values[i] = new(sql.NullString)
+		case ban.FieldCreatedAt, ban.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case ban.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Ban", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Ban fields.
+func (b *Ban) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ban.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				b.ID = *value
+			}
+		case ban.FieldSteamid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field steamid", values[i])
+			} else if value.Valid {
+				b.Steamid = value.String
+			}
+		case ban.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				b.Reason = value.String
+			}
+		case ban.FieldIssuedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field issued_by", values[i])
+			} else if value.Valid {
+				b.IssuedBy = value.String
+			}
+		case ban.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				b.CreatedAt = value.Time
+			}
+		case ban.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				b.ExpiresAt = new(time.Time)
+				*b.ExpiresAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Ban.
+// Note that you need to call Ban.Unwrap() before calling this method if this Ban
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Ban) Update() *BanUpdateOne {
+	return (&BanClient{config: b.config}).UpdateOne(b)
+}
+
+// Unwrap unwraps the Ban entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (b *Ban) Unwrap() *Ban {
+	tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Ban is not a transactional entity")
+	}
+	b.config.driver = tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Ban) String() string {
+	var builder strings.Builder
+	builder.WriteString("Ban(")
+	builder.WriteString(fmt.Sprintf("id=%v", b.ID))
+	builder.WriteString(", steamid=")
+	builder.WriteString(b.Steamid)
+	builder.WriteString(", reason=")
+	builder.WriteString(b.Reason)
+	builder.WriteString(", issued_by=")
+	builder.WriteString(b.IssuedBy)
+	builder.WriteString(", created_at=")
+	builder.WriteString(b.CreatedAt.Format(time.ANSIC))
+	if v := b.ExpiresAt; v != nil {
+		builder.WriteString(", expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Bans is a parsable slice of Ban.
+type Bans []*Ban
+
+func (b Bans) config(cfg config) {
+	for _i := range b {
+		b[_i].config = cfg
+	}
+}
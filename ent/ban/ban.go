@@ -0,0 +1,61 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ban
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the ban type in the database.
+	Label = "ban"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSteamid holds the string denoting the steamid field in the database.
+	FieldSteamid = "steamid"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldIssuedBy holds the string denoting the issued_by field in the database.
+	FieldIssuedBy = "issued_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// Table holds the table name of the ban in the database.
+	Table = "bans"
+)
+
+// Columns holds all SQL columns for ban fields.
+var Columns = []string{
+	FieldID,
+	FieldSteamid,
+	FieldReason,
+	FieldIssuedBy,
+	FieldCreatedAt,
+	FieldExpiresAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SteamidValidator is a validator for the "steamid" field. It is called by the builders before save.
+	SteamidValidator func(string) error
+	// DefaultReason holds the default value on creation for the "reason" field.
+	DefaultReason string
+	// DefaultIssuedBy holds the default value on creation for the "issued_by" field.
+	DefaultIssuedBy string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
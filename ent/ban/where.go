@@ -0,0 +1,660 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ban
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Steamid applies equality check predicate on the "steamid" field. It's identical to SteamidEQ.
+func Steamid(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSteamid), v))
+	})
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReason), v))
+	})
+}
+
+// IssuedBy applies equality check predicate on the "issued_by" field. It's identical to IssuedByEQ.
+func IssuedBy(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIssuedBy), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// SteamidEQ applies the EQ predicate on the "steamid" field.
+func SteamidEQ(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidNEQ applies the NEQ predicate on the "steamid" field.
+func SteamidNEQ(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidIn applies the In predicate on the "steamid" field.
+func SteamidIn(vs ...string) predicate.Ban {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Ban(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSteamid), v...))
+	})
+}
+
+// SteamidNotIn applies the NotIn predicate on the "steamid" field.
+func SteamidNotIn(vs ...string) predicate.Ban {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Ban(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSteamid), v...))
+	})
+}
+
+// SteamidGT applies the GT predicate on the "steamid" field.
+func SteamidGT(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidGTE applies the GTE predicate on the "steamid" field.
+func SteamidGTE(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidLT applies the LT predicate on the "steamid" field.
+func SteamidLT(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidLTE applies the LTE predicate on the "steamid" field.
+func SteamidLTE(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidContains applies the Contains predicate on the "steamid" field.
+func SteamidContains(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidHasPrefix applies the HasPrefix predicate on the "steamid" field.
+func SteamidHasPrefix(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidHasSuffix applies the HasSuffix predicate on the "steamid" field.
+func SteamidHasSuffix(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidEqualFold applies the EqualFold predicate on the "steamid" field.
+func SteamidEqualFold(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSteamid), v))
+	})
+}
+
+// SteamidContainsFold applies the ContainsFold predicate on the "steamid" field.
+func SteamidContainsFold(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSteamid), v))
+	})
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReason), v))
+	})
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReason), v))
+	})
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.Ban {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Ban(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReason), v...))
+	})
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.Ban {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Ban(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReason), v...))
+	})
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReason), v))
+	})
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReason), v))
+	})
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReason), v))
+	})
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReason), v))
+	})
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldReason), v))
+	})
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldReason), v))
+	})
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldReason), v))
+	})
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldReason), v))
+	})
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldReason), v))
+	})
+}
+
+// IssuedByEQ applies the EQ predicate on the "issued_by" field.
+func IssuedByEQ(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIssuedBy), v))
+	})
+}
+
+// IssuedByNEQ applies the NEQ predicate on the "issued_by" field.
+func IssuedByNEQ(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIssuedBy), v))
+	})
+}
+
+// IssuedByIn applies the In predicate on the "issued_by" field.
+func IssuedByIn(vs ...string) predicate.Ban {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Ban(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldIssuedBy), v...))
+	})
+}
+
+// IssuedByNotIn applies the NotIn predicate on the "issued_by" field.
+func IssuedByNotIn(vs ...string) predicate.Ban {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Ban(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldIssuedBy), v...))
+	})
+}
+
+// IssuedByGT applies the GT predicate on the "issued_by" field.
+func IssuedByGT(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIssuedBy), v))
+	})
+}
+
+// IssuedByGTE applies the GTE predicate on the "issued_by" field.
+func IssuedByGTE(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIssuedBy), v))
+	})
+}
+
+// IssuedByLT applies the LT predicate on the "issued_by" field.
+func IssuedByLT(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIssuedBy), v))
+	})
+}
+
+// IssuedByLTE applies the LTE predicate on the "issued_by" field.
+func IssuedByLTE(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIssuedBy), v))
+	})
+}
+
+// IssuedByContains applies the Contains predicate on the "issued_by" field.
+func IssuedByContains(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldIssuedBy), v))
+	})
+}
+
+// IssuedByHasPrefix applies the HasPrefix predicate on the "issued_by" field.
+func IssuedByHasPrefix(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldIssuedBy), v))
+	})
+}
+
+// IssuedByHasSuffix applies the HasSuffix predicate on the "issued_by" field.
+func IssuedByHasSuffix(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldIssuedBy), v))
+	})
+}
+
+// IssuedByEqualFold applies the EqualFold predicate on the "issued_by" field.
+func IssuedByEqualFold(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldIssuedBy), v))
+	})
+}
+
+// IssuedByContainsFold applies the ContainsFold predicate on the "issued_by" field.
+func IssuedByContainsFold(v string) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldIssuedBy), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Ban {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Ban(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Ban {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Ban(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Ban {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Ban(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Ban {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Ban(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldExpiresAt)))
+	})
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldExpiresAt)))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Ban) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Ban) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Ban) predicate.Ban {
+	return predicate.Ban(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
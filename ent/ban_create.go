@@ -0,0 +1,365 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/ban"
+)
+
+// BanCreate is the builder for creating a Ban entity.
+type BanCreate struct {
+	config
+	mutation *BanMutation
+	hooks    []Hook
+}
+
+// SetSteamid sets the "steamid" field.
+func (bc *BanCreate) SetSteamid(s string) *BanCreate {
+	bc.mutation.SetSteamid(s)
+	return bc
+}
+
+// SetReason sets the "reason" field.
+func (bc *BanCreate) SetReason(s string) *BanCreate {
+	bc.mutation.SetReason(s)
+	return bc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (bc *BanCreate) SetNillableReason(s *string) *BanCreate {
+	if s != nil {
+		bc.SetReason(*s)
+	}
+	return bc
+}
+
+// SetIssuedBy sets the "issued_by" field.
+func (bc *BanCreate) SetIssuedBy(s string) *BanCreate {
+	bc.mutation.SetIssuedBy(s)
+	return bc
+}
+
+// SetNillableIssuedBy sets the "issued_by" field if the given value is not nil.
+func (bc *BanCreate) SetNillableIssuedBy(s *string) *BanCreate {
+	if s != nil {
+		bc.SetIssuedBy(*s)
+	}
+	return bc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bc *BanCreate) SetCreatedAt(t time.Time) *BanCreate {
+	bc.mutation.SetCreatedAt(t)
+	return bc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bc *BanCreate) SetNillableCreatedAt(t *time.Time) *BanCreate {
+	if t != nil {
+		bc.SetCreatedAt(*t)
+	}
+	return bc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (bc *BanCreate) SetExpiresAt(t time.Time) *BanCreate {
+	bc.mutation.SetExpiresAt(t)
+	return bc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (bc *BanCreate) SetNillableExpiresAt(t *time.Time) *BanCreate {
+	if t != nil {
+		bc.SetExpiresAt(*t)
+	}
+	return bc
+}
+
+// SetID sets the "id" field.
+func (bc *BanCreate) SetID(u uuid.UUID) *BanCreate {
+	bc.mutation.SetID(u)
+	return bc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (bc *BanCreate) SetNillableID(u *uuid.UUID) *BanCreate {
+	if u != nil {
+		bc.SetID(*u)
+	}
+	return bc
+}
+
+// Mutation returns the BanMutation object of the builder.
+func (bc *BanCreate) Mutation() *BanMutation {
+	return bc.mutation
+}
+
+// Save creates the Ban in the database.
+func (bc *BanCreate) Save(ctx context.Context) (*Ban, error) {
+	var (
+		err  error
+		node *Ban
+	)
+	bc.defaults()
+	if len(bc.hooks) == 0 {
+		if err = bc.check(); err != nil {
+			return nil, err
+		}
+		node, err = bc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BanMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bc.check(); err != nil {
+				return nil, err
+			}
+			bc.mutation = mutation
+			if node, err = bc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(bc.hooks) - 1; i >= 0; i-- {
+			if bc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bc *BanCreate) SaveX(ctx context.Context) *Ban {
+	v, err := bc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bc *BanCreate) Exec(ctx context.Context) error {
+	_, err := bc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bc *BanCreate) ExecX(ctx context.Context) {
+	if err := bc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bc *BanCreate) defaults() {
+	if _, ok := bc.mutation.Reason(); !ok {
+		v := ban.DefaultReason
+		bc.mutation.SetReason(v)
+	}
+	if _, ok := bc.mutation.IssuedBy(); !ok {
+		v := ban.DefaultIssuedBy
+		bc.mutation.SetIssuedBy(v)
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		v := ban.DefaultCreatedAt()
+		bc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := bc.mutation.ID(); !ok {
+		v := ban.DefaultID()
+		bc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bc *BanCreate) check() error {
+	if _, ok := bc.mutation.Steamid(); !ok {
+		return &ValidationError{Name: "steamid", err: errors.New(`ent: missing required field "Ban.steamid"`)}
+	}
+	if v, ok := bc.mutation.Steamid(); ok {
+		if err := ban.SteamidValidator(v); err != nil {
+			return &ValidationError{Name: "steamid", err: fmt.Errorf(`ent: validator failed for field "Ban.steamid": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "Ban.reason"`)}
+	}
+	if _, ok := bc.mutation.IssuedBy(); !ok {
+		return &ValidationError{Name: "issued_by", err: errors.New(`ent: missing required field "Ban.issued_by"`)}
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Ban.created_at"`)}
+	}
+	return nil
+}
+
+func (bc *BanCreate) sqlSave(ctx context.Context) (*Ban, error) {
+	_node, _spec := bc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (bc *BanCreate) createSpec() (*Ban, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Ban{config: bc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: ban.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: ban.FieldID,
+			},
+		}
+	)
+	if id, ok := bc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := bc.mutation.Steamid(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ban.FieldSteamid,
+		})
+		_node.Steamid = value
+	}
+	if value, ok := bc.mutation.Reason(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ban.FieldReason,
+		})
+		_node.Reason = value
+	}
+	if value, ok := bc.mutation.IssuedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ban.FieldIssuedBy,
+		})
+		_node.IssuedBy = value
+	}
+	if value, ok := bc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ban.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := bc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ban.FieldExpiresAt,
+		})
+		_node.ExpiresAt = &value
+	}
+	return _node, _spec
+}
+
+// BanCreateBulk is the builder for creating many Ban entities in bulk.
+type BanCreateBulk struct {
+	config
+	builders []*BanCreate
+}
+
+// Save creates the Ban entities in the database.
+func (bcb *BanCreateBulk) Save(ctx context.Context) ([]*Ban, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
+	nodes := make([]*Ban, len(bcb.builders))
+	mutators := make([]Mutator, len(bcb.builders))
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcb *BanCreateBulk) SaveX(ctx context.Context) []*Ban {
+	v, err := bcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcb *BanCreateBulk) Exec(ctx context.Context) error {
+	_, err := bcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcb *BanCreateBulk) ExecX(ctx context.Context) {
+	if err := bcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
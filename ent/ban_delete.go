@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/msrevive/nexus2/ent/ban"
+	"github.com/msrevive/nexus2/ent/predicate"
+)
+
+// BanDelete is the builder for deleting a Ban entity.
+type BanDelete struct {
+	config
+	hooks    []Hook
+	mutation *BanMutation
+}
+
+// Where appends a list predicates to the BanDelete builder.
+func (bd *BanDelete) Where(ps ...predicate.Ban) *BanDelete {
+	bd.mutation.Where(ps...)
+	return bd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bd *BanDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(bd.hooks) == 0 {
+		affected, err = bd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BanMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			bd.mutation = mutation
+			affected, err = bd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(bd.hooks) - 1; i >= 0; i-- {
+			if bd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bd *BanDelete) ExecX(ctx context.Context) int {
+	n, err := bd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bd *BanDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: ban.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: ban.FieldID,
+			},
+		},
+	}
+	if ps := bd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, bd.driver, _spec)
+}
+
+// BanDeleteOne is the builder for deleting a single Ban entity.
+type BanDeleteOne struct {
+	bd *BanDelete
+}
+
+// Exec executes the deletion query.
+func (bdo *BanDeleteOne) Exec(ctx context.Context) error {
+	n, err := bdo.bd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ban.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bdo *BanDeleteOne) ExecX(ctx context.Context) {
+	bdo.bd.ExecX(ctx)
+}
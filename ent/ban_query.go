@@ -0,0 +1,921 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/ban"
+	"github.com/msrevive/nexus2/ent/predicate"
+)
+
+// BanQuery is the builder for querying Ban entities.
+type BanQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Ban
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BanQuery builder.
+func (bq *BanQuery) Where(ps ...predicate.Ban) *BanQuery {
+	bq.predicates = append(bq.predicates, ps...)
+	return bq
+}
+
+// Limit adds a limit step to the query.
+func (bq *BanQuery) Limit(limit int) *BanQuery {
+	bq.limit = &limit
+	return bq
+}
+
+// Offset adds an offset step to the query.
+func (bq *BanQuery) Offset(offset int) *BanQuery {
+	bq.offset = &offset
+	return bq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (bq *BanQuery) Unique(unique bool) *BanQuery {
+	bq.unique = &unique
+	return bq
+}
+
+// Order adds an order step to the query.
+func (bq *BanQuery) Order(o ...OrderFunc) *BanQuery {
+	bq.order = append(bq.order, o...)
+	return bq
+}
+
+// First returns the first Ban entity from the query.
+// Returns a *NotFoundError when no Ban was found.
+func (bq *BanQuery) First(ctx context.Context) (*Ban, error) {
+	nodes, err := bq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ban.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bq *BanQuery) FirstX(ctx context.Context) *Ban {
+	node, err := bq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Ban ID from the query.
+// Returns a *NotFoundError when no Ban ID was found.
+func (bq *BanQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = bq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ban.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (bq *BanQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := bq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Ban entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Ban entity is found.
+// Returns a *NotFoundError when no Ban entities are found.
+func (bq *BanQuery) Only(ctx context.Context) (*Ban, error) {
+	nodes, err := bq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ban.Label}
+	default:
+		return nil, &NotSingularError{ban.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bq *BanQuery) OnlyX(ctx context.Context) *Ban {
+	node, err := bq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Ban ID in the query.
+// Returns a *NotSingularError when more than one Ban ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (bq *BanQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = bq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ban.Label}
+	default:
+		err = &NotSingularError{ban.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (bq *BanQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := bq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Bans.
+func (bq *BanQuery) All(ctx context.Context) ([]*Ban, error) {
+	if err := bq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return bq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bq *BanQuery) AllX(ctx context.Context) []*Ban {
+	nodes, err := bq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Ban IDs.
+func (bq *BanQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := bq.Select(ban.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bq *BanQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := bq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bq *BanQuery) Count(ctx context.Context) (int, error) {
+	if err := bq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return bq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bq *BanQuery) CountX(ctx context.Context) int {
+	count, err := bq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bq *BanQuery) Exist(ctx context.Context) (bool, error) {
+	if err := bq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return bq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bq *BanQuery) ExistX(ctx context.Context) bool {
+	exist, err := bq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BanQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bq *BanQuery) Clone() *BanQuery {
+	if bq == nil {
+		return nil
+	}
+	return &BanQuery{
+		config:     bq.config,
+		limit:      bq.limit,
+		offset:     bq.offset,
+		order:      append([]OrderFunc{}, bq.order...),
+		predicates: append([]predicate.Ban{}, bq.predicates...),
+		// clone intermediate query.
+		sql:  bq.sql.Clone(),
+		path: bq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Steamid string `json:"steamid,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Ban.Query().
+//		GroupBy(ban.FieldSteamid).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (bq *BanQuery) GroupBy(field string, fields ...string) *BanGroupBy {
+	group := &BanGroupBy{config: bq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return bq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Steamid string `json:"steamid,omitempty"`
+//	}
+//
+//	client.Ban.Query().
+//		Select(ban.FieldSteamid).
+//		Scan(ctx, &v)
+//
+func (bq *BanQuery) Select(fields ...string) *BanSelect {
+	bq.fields = append(bq.fields, fields...)
+	return &BanSelect{BanQuery: bq}
+}
+
+func (bq *BanQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range bq.fields {
+		if !ban.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if bq.path != nil {
+		prev, err := bq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bq.sql = prev
+	}
+	return nil
+}
+
+func (bq *BanQuery) sqlAll(ctx context.Context) ([]*Ban, error) {
+	var (
+		nodes = []*Ban{}
+		_spec = bq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &Ban{config: bq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, bq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (bq *BanQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bq.querySpec()
+	_spec.Node.Columns = bq.fields
+	if len(bq.fields) > 0 {
+		_spec.Unique = bq.unique != nil && *bq.unique
+	}
+	return sqlgraph.CountNodes(ctx, bq.driver, _spec)
+}
+
+func (bq *BanQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := bq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (bq *BanQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ban.Table,
+			Columns: ban.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: ban.FieldID,
+			},
+		},
+		From:   bq.sql,
+		Unique: true,
+	}
+	if unique := bq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := bq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ban.FieldID)
+		for i := range fields {
+			if fields[i] != ban.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := bq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bq *BanQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(bq.driver.Dialect())
+	t1 := builder.Table(ban.Table)
+	columns := bq.fields
+	if len(columns) == 0 {
+		columns = ban.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if bq.sql != nil {
+		selector = bq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if bq.unique != nil && *bq.unique {
+		selector.Distinct()
+	}
+	for _, p := range bq.predicates {
+		p(selector)
+	}
+	for _, p := range bq.order {
+		p(selector)
+	}
+	if offset := bq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BanGroupBy is the group-by builder for Ban entities.
+type BanGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bgb *BanGroupBy) Aggregate(fns ...AggregateFunc) *BanGroupBy {
+	bgb.fns = append(bgb.fns, fns...)
+	return bgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (bgb *BanGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := bgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	bgb.sql = query
+	return bgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (bgb *BanGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := bgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (bgb *BanGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(bgb.fields) > 1 {
+		return nil, errors.New("ent: BanGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := bgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (bgb *BanGroupBy) StringsX(ctx context.Context) []string {
+	v, err := bgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (bgb *BanGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = bgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ban.Label}
+	default:
+		err = fmt.Errorf("ent: BanGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (bgb *BanGroupBy) StringX(ctx context.Context) string {
+	v, err := bgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (bgb *BanGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(bgb.fields) > 1 {
+		return nil, errors.New("ent: BanGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := bgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (bgb *BanGroupBy) IntsX(ctx context.Context) []int {
+	v, err := bgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (bgb *BanGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = bgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ban.Label}
+	default:
+		err = fmt.Errorf("ent: BanGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (bgb *BanGroupBy) IntX(ctx context.Context) int {
+	v, err := bgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (bgb *BanGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(bgb.fields) > 1 {
+		return nil, errors.New("ent: BanGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := bgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (bgb *BanGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := bgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (bgb *BanGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = bgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ban.Label}
+	default:
+		err = fmt.Errorf("ent: BanGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (bgb *BanGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := bgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (bgb *BanGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(bgb.fields) > 1 {
+		return nil, errors.New("ent: BanGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := bgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (bgb *BanGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := bgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (bgb *BanGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = bgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ban.Label}
+	default:
+		err = fmt.Errorf("ent: BanGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (bgb *BanGroupBy) BoolX(ctx context.Context) bool {
+	v, err := bgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (bgb *BanGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range bgb.fields {
+		if !ban.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := bgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (bgb *BanGroupBy) sqlQuery() *sql.Selector {
+	selector := bgb.sql.Select()
+	aggregation := make([]string, 0, len(bgb.fns))
+	for _, fn := range bgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(bgb.fields)+len(bgb.fns))
+		for _, f := range bgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(bgb.fields...)...)
+}
+
+// BanSelect is the builder for selecting fields of Ban entities.
+type BanSelect struct {
+	*BanQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bs *BanSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := bs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	bs.sql = bs.BanQuery.sqlQuery(ctx)
+	return bs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (bs *BanSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := bs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (bs *BanSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(bs.fields) > 1 {
+		return nil, errors.New("ent: BanSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := bs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (bs *BanSelect) StringsX(ctx context.Context) []string {
+	v, err := bs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (bs *BanSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = bs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ban.Label}
+	default:
+		err = fmt.Errorf("ent: BanSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (bs *BanSelect) StringX(ctx context.Context) string {
+	v, err := bs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (bs *BanSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(bs.fields) > 1 {
+		return nil, errors.New("ent: BanSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := bs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (bs *BanSelect) IntsX(ctx context.Context) []int {
+	v, err := bs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (bs *BanSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = bs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ban.Label}
+	default:
+		err = fmt.Errorf("ent: BanSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (bs *BanSelect) IntX(ctx context.Context) int {
+	v, err := bs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (bs *BanSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(bs.fields) > 1 {
+		return nil, errors.New("ent: BanSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := bs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (bs *BanSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := bs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (bs *BanSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = bs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ban.Label}
+	default:
+		err = fmt.Errorf("ent: BanSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (bs *BanSelect) Float64X(ctx context.Context) float64 {
+	v, err := bs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (bs *BanSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(bs.fields) > 1 {
+		return nil, errors.New("ent: BanSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := bs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (bs *BanSelect) BoolsX(ctx context.Context) []bool {
+	v, err := bs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (bs *BanSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = bs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ban.Label}
+	default:
+		err = fmt.Errorf("ent: BanSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (bs *BanSelect) BoolX(ctx context.Context) bool {
+	v, err := bs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (bs *BanSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := bs.sql.Query()
+	if err := bs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,448 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/msrevive/nexus2/ent/ban"
+	"github.com/msrevive/nexus2/ent/predicate"
+)
+
+// BanUpdate is the builder for updating Ban entities.
+type BanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BanMutation
+}
+
+// Where appends a list predicates to the BanUpdate builder.
+func (bu *BanUpdate) Where(ps ...predicate.Ban) *BanUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetSteamid sets the "steamid" field.
+func (bu *BanUpdate) SetSteamid(s string) *BanUpdate {
+	bu.mutation.SetSteamid(s)
+	return bu
+}
+
+// SetReason sets the "reason" field.
+func (bu *BanUpdate) SetReason(s string) *BanUpdate {
+	bu.mutation.SetReason(s)
+	return bu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (bu *BanUpdate) SetNillableReason(s *string) *BanUpdate {
+	if s != nil {
+		bu.SetReason(*s)
+	}
+	return bu
+}
+
+// SetIssuedBy sets the "issued_by" field.
+func (bu *BanUpdate) SetIssuedBy(s string) *BanUpdate {
+	bu.mutation.SetIssuedBy(s)
+	return bu
+}
+
+// SetNillableIssuedBy sets the "issued_by" field if the given value is not nil.
+func (bu *BanUpdate) SetNillableIssuedBy(s *string) *BanUpdate {
+	if s != nil {
+		bu.SetIssuedBy(*s)
+	}
+	return bu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (bu *BanUpdate) SetExpiresAt(t time.Time) *BanUpdate {
+	bu.mutation.SetExpiresAt(t)
+	return bu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (bu *BanUpdate) SetNillableExpiresAt(t *time.Time) *BanUpdate {
+	if t != nil {
+		bu.SetExpiresAt(*t)
+	}
+	return bu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (bu *BanUpdate) ClearExpiresAt() *BanUpdate {
+	bu.mutation.ClearExpiresAt()
+	return bu
+}
+
+// Mutation returns the BanMutation object of the builder.
+func (bu *BanUpdate) Mutation() *BanMutation {
+	return bu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BanUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(bu.hooks) == 0 {
+		if err = bu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = bu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BanMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bu.check(); err != nil {
+				return 0, err
+			}
+			bu.mutation = mutation
+			affected, err = bu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(bu.hooks) - 1; i >= 0; i-- {
+			if bu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BanUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BanUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BanUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bu *BanUpdate) check() error {
+	if v, ok := bu.mutation.Steamid(); ok {
+		if err := ban.SteamidValidator(v); err != nil {
+			return &ValidationError{Name: "steamid", err: fmt.Errorf(`ent: validator failed for field "Ban.steamid": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bu *BanUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ban.Table,
+			Columns: ban.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: ban.FieldID,
+			},
+		},
+	}
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.Steamid(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ban.FieldSteamid,
+		})
+	}
+	if value, ok := bu.mutation.Reason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ban.FieldReason,
+		})
+	}
+	if value, ok := bu.mutation.IssuedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ban.FieldIssuedBy,
+		})
+	}
+	if value, ok := bu.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ban.FieldExpiresAt,
+		})
+	}
+	if bu.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: ban.FieldExpiresAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ban.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// BanUpdateOne is the builder for updating a single Ban entity.
+type BanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BanMutation
+}
+
+// SetSteamid sets the "steamid" field.
+func (buo *BanUpdateOne) SetSteamid(s string) *BanUpdateOne {
+	buo.mutation.SetSteamid(s)
+	return buo
+}
+
+// SetReason sets the "reason" field.
+func (buo *BanUpdateOne) SetReason(s string) *BanUpdateOne {
+	buo.mutation.SetReason(s)
+	return buo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (buo *BanUpdateOne) SetNillableReason(s *string) *BanUpdateOne {
+	if s != nil {
+		buo.SetReason(*s)
+	}
+	return buo
+}
+
+// SetIssuedBy sets the "issued_by" field.
+func (buo *BanUpdateOne) SetIssuedBy(s string) *BanUpdateOne {
+	buo.mutation.SetIssuedBy(s)
+	return buo
+}
+
+// SetNillableIssuedBy sets the "issued_by" field if the given value is not nil.
+func (buo *BanUpdateOne) SetNillableIssuedBy(s *string) *BanUpdateOne {
+	if s != nil {
+		buo.SetIssuedBy(*s)
+	}
+	return buo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (buo *BanUpdateOne) SetExpiresAt(t time.Time) *BanUpdateOne {
+	buo.mutation.SetExpiresAt(t)
+	return buo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (buo *BanUpdateOne) SetNillableExpiresAt(t *time.Time) *BanUpdateOne {
+	if t != nil {
+		buo.SetExpiresAt(*t)
+	}
+	return buo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (buo *BanUpdateOne) ClearExpiresAt() *BanUpdateOne {
+	buo.mutation.ClearExpiresAt()
+	return buo
+}
+
+// Mutation returns the BanMutation object of the builder.
+func (buo *BanUpdateOne) Mutation() *BanMutation {
+	return buo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BanUpdateOne) Select(field string, fields ...string) *BanUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Ban entity.
+func (buo *BanUpdateOne) Save(ctx context.Context) (*Ban, error) {
+	var (
+		err  error
+		node *Ban
+	)
+	if len(buo.hooks) == 0 {
+		if err = buo.check(); err != nil {
+			return nil, err
+		}
+		node, err = buo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BanMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = buo.check(); err != nil {
+				return nil, err
+			}
+			buo.mutation = mutation
+			node, err = buo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(buo.hooks) - 1; i >= 0; i-- {
+			if buo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = buo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, buo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BanUpdateOne) SaveX(ctx context.Context) *Ban {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BanUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BanUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (buo *BanUpdateOne) check() error {
+	if v, ok := buo.mutation.Steamid(); ok {
+		if err := ban.SteamidValidator(v); err != nil {
+			return &ValidationError{Name: "steamid", err: fmt.Errorf(`ent: validator failed for field "Ban.steamid": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (buo *BanUpdateOne) sqlSave(ctx context.Context) (_node *Ban, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ban.Table,
+			Columns: ban.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: ban.FieldID,
+			},
+		},
+	}
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Ban.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ban.FieldID)
+		for _, f := range fields {
+			if !ban.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ban.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.Steamid(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ban.FieldSteamid,
+		})
+	}
+	if value, ok := buo.mutation.Reason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ban.FieldReason,
+		})
+	}
+	if value, ok := buo.mutation.IssuedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: ban.FieldIssuedBy,
+		})
+	}
+	if value, ok := buo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ban.FieldExpiresAt,
+		})
+	}
+	if buo.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: ban.FieldExpiresAt,
+		})
+	}
+	_node = &Ban{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ban.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
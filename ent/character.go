@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
@@ -24,6 +25,37 @@ type Character struct {
 	Size int `json:"size,omitempty"`
 	// Data holds the value of the "data" field.
 	Data string `json:"data,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Live holds the value of the "live" field.
+	Live *bool `json:"-"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CharacterQuery when eager-loading is set.
+	Edges CharacterEdges `json:"edges"`
+}
+
+// CharacterEdges holds the relations/edges for other nodes in the graph.
+type CharacterEdges struct {
+	// Versions holds the value of the versions edge.
+	Versions []*CharacterVersion `json:"versions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// VersionsOrErr returns the Versions value or an error if the edge
+// was not loaded in eager-loading.
+func (e CharacterEdges) VersionsOrErr() ([]*CharacterVersion, error) {
+	if e.loadedTypes[0] {
+		return e.Versions, nil
+	}
+	return nil, &NotLoadedError{edge: "versions"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -31,10 +63,14 @@ func (*Character) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case character.FieldSlot, character.FieldSize:
+		case character.FieldLive:
+			values[i] = new(sql.NullBool)
+		case character.FieldSlot, character.FieldSize, character.FieldVersion:
 			values[i] = new(sql.NullInt64)
 		case character.FieldSteamid, character.FieldData:
 			values[i] = new(sql.NullString)
+		case character.FieldDeletedAt, character.FieldCreatedAt, character.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
 		case character.FieldID:
 			values[i] = new(uuid.UUID)
 		default:
@@ -82,11 +118,50 @@ func (c *Character) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				c.Data = value.String
 			}
+		case character.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				c.DeletedAt = new(time.Time)
+				*c.DeletedAt = value.Time
+			}
+		case character.FieldLive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field live", values[i])
+			} else if value.Valid {
+				c.Live = new(bool)
+				*c.Live = value.Bool
+			}
+		case character.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = new(time.Time)
+				*c.CreatedAt = value.Time
+			}
+		case character.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = new(time.Time)
+				*c.UpdatedAt = value.Time
+			}
+		case character.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				c.Version = int(value.Int64)
+			}
 		}
 	}
 	return nil
 }
 
+// QueryVersions queries the "versions" edge of the Character entity.
+func (c *Character) QueryVersions() *CharacterVersionQuery {
+	return (&CharacterClient{config: c.config}).QueryVersions(c)
+}
+
 // Update returns a builder for updating this Character.
 // Note that you need to call Character.Unwrap() before calling this method if this Character
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -118,6 +193,24 @@ func (c *Character) String() string {
 	builder.WriteString(fmt.Sprintf("%v", c.Size))
 	builder.WriteString(", data=")
 	builder.WriteString(c.Data)
+	if v := c.DeletedAt; v != nil {
+		builder.WriteString(", deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := c.Live; v != nil {
+		builder.WriteString(", live=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	if v := c.CreatedAt; v != nil {
+		builder.WriteString(", created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := c.UpdatedAt; v != nil {
+		builder.WriteString(", updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", version=")
+	builder.WriteString(fmt.Sprintf("%v", c.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
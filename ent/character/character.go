@@ -3,6 +3,8 @@
 package character
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -19,8 +21,27 @@ const (
 	FieldSize = "size"
 	// FieldData holds the string denoting the data field in the database.
 	FieldData = "data"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldLive holds the string denoting the live field in the database.
+	FieldLive = "live"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// EdgeVersions holds the string denoting the versions edge name in mutations.
+	EdgeVersions = "versions"
 	// Table holds the table name of the character in the database.
 	Table = "characters"
+	// VersionsTable is the table that holds the versions relation/edge.
+	VersionsTable = "character_versions"
+	// VersionsInverseTable is the table name for the CharacterVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "characterversion" package.
+	VersionsInverseTable = "character_versions"
+	// VersionsColumn is the table column denoting the versions relation/edge.
+	VersionsColumn = "character_id"
 )
 
 // Columns holds all SQL columns for character fields.
@@ -30,6 +51,11 @@ var Columns = []string{
 	FieldSlot,
 	FieldSize,
 	FieldData,
+	FieldDeletedAt,
+	FieldLive,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldVersion,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -49,6 +75,14 @@ var (
 	SlotValidator func(int) error
 	// DefaultSize holds the default value on creation for the "size" field.
 	DefaultSize int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion func() int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
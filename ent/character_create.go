@@ -6,11 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/msrevive/nexus2/ent/character"
+	"github.com/msrevive/nexus2/ent/characterversion"
 )
 
 // CharacterCreate is the builder for creating a Character entity.
@@ -60,6 +62,76 @@ func (cc *CharacterCreate) SetData(s string) *CharacterCreate {
 	return cc
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (cc *CharacterCreate) SetDeletedAt(t time.Time) *CharacterCreate {
+	cc.mutation.SetDeletedAt(t)
+	return cc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cc *CharacterCreate) SetNillableDeletedAt(t *time.Time) *CharacterCreate {
+	if t != nil {
+		cc.SetDeletedAt(*t)
+	}
+	return cc
+}
+
+// SetLive sets the "live" field.
+func (cc *CharacterCreate) SetLive(b bool) *CharacterCreate {
+	cc.mutation.SetLive(b)
+	return cc
+}
+
+// SetNillableLive sets the "live" field if the given value is not nil.
+func (cc *CharacterCreate) SetNillableLive(b *bool) *CharacterCreate {
+	if b != nil {
+		cc.SetLive(*b)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CharacterCreate) SetCreatedAt(t time.Time) *CharacterCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CharacterCreate) SetNillableCreatedAt(t *time.Time) *CharacterCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cc *CharacterCreate) SetUpdatedAt(t time.Time) *CharacterCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cc *CharacterCreate) SetNillableUpdatedAt(t *time.Time) *CharacterCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// SetVersion sets the "version" field.
+func (cc *CharacterCreate) SetVersion(i int) *CharacterCreate {
+	cc.mutation.SetVersion(i)
+	return cc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (cc *CharacterCreate) SetNillableVersion(i *int) *CharacterCreate {
+	if i != nil {
+		cc.SetVersion(*i)
+	}
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *CharacterCreate) SetID(u uuid.UUID) *CharacterCreate {
 	cc.mutation.SetID(u)
@@ -74,6 +146,21 @@ func (cc *CharacterCreate) SetNillableID(u *uuid.UUID) *CharacterCreate {
 	return cc
 }
 
+// AddVersionIDs adds the "versions" edge to the CharacterVersion entity by IDs.
+func (cc *CharacterCreate) AddVersionIDs(ids ...uuid.UUID) *CharacterCreate {
+	cc.mutation.AddVersionIDs(ids...)
+	return cc
+}
+
+// AddVersions adds the "versions" edges to the CharacterVersion entity.
+func (cc *CharacterCreate) AddVersions(c ...*CharacterVersion) *CharacterCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddVersionIDs(ids...)
+}
+
 // Mutation returns the CharacterMutation object of the builder.
 func (cc *CharacterCreate) Mutation() *CharacterMutation {
 	return cc.mutation
@@ -153,6 +240,18 @@ func (cc *CharacterCreate) defaults() {
 		v := character.DefaultSize
 		cc.mutation.SetSize(v)
 	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := character.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		v := character.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cc.mutation.Version(); !ok {
+		v := character.DefaultVersion()
+		cc.mutation.SetVersion(v)
+	}
 	if _, ok := cc.mutation.ID(); !ok {
 		v := character.DefaultID()
 		cc.mutation.SetID(v)
@@ -246,6 +345,65 @@ func (cc *CharacterCreate) createSpec() (*Character, *sqlgraph.CreateSpec) {
 		})
 		_node.Data = value
 	}
+	if value, ok := cc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: character.FieldDeletedAt,
+		})
+		_node.DeletedAt = &value
+	}
+	if value, ok := cc.mutation.Live(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: character.FieldLive,
+		})
+		_node.Live = &value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: character.FieldCreatedAt,
+		})
+		_node.CreatedAt = &value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: character.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = &value
+	}
+	if value, ok := cc.mutation.Version(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: character.FieldVersion,
+		})
+		_node.Version = value
+	}
+	if nodes := cc.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   character.VersionsTable,
+			Columns: []string{character.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: characterversion.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
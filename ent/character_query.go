@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"math"
@@ -13,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/msrevive/nexus2/ent/character"
+	"github.com/msrevive/nexus2/ent/characterversion"
 	"github.com/msrevive/nexus2/ent/predicate"
 )
 
@@ -25,6 +27,8 @@ type CharacterQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Character
+	// eager-loading edges.
+	withVersions *CharacterVersionQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -61,6 +65,28 @@ func (cq *CharacterQuery) Order(o ...OrderFunc) *CharacterQuery {
 	return cq
 }
 
+// QueryVersions chains the current query on the "versions" edge.
+func (cq *CharacterQuery) QueryVersions() *CharacterVersionQuery {
+	query := &CharacterVersionQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(character.Table, character.FieldID, selector),
+			sqlgraph.To(characterversion.Table, characterversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, character.VersionsTable, character.VersionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Character entity from the query.
 // Returns a *NotFoundError when no Character was found.
 func (cq *CharacterQuery) First(ctx context.Context) (*Character, error) {
@@ -237,17 +263,29 @@ func (cq *CharacterQuery) Clone() *CharacterQuery {
 		return nil
 	}
 	return &CharacterQuery{
-		config:     cq.config,
-		limit:      cq.limit,
-		offset:     cq.offset,
-		order:      append([]OrderFunc{}, cq.order...),
-		predicates: append([]predicate.Character{}, cq.predicates...),
+		config:       cq.config,
+		limit:        cq.limit,
+		offset:       cq.offset,
+		order:        append([]OrderFunc{}, cq.order...),
+		predicates:   append([]predicate.Character{}, cq.predicates...),
+		withVersions: cq.withVersions.Clone(),
 		// clone intermediate query.
 		sql:  cq.sql.Clone(),
 		path: cq.path,
 	}
 }
 
+// WithVersions tells the query-builder to eager-load the nodes that are connected to
+// the "versions" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CharacterQuery) WithVersions(opts ...func(*CharacterVersionQuery)) *CharacterQuery {
+	query := &CharacterVersionQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withVersions = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -311,8 +349,11 @@ func (cq *CharacterQuery) prepareQuery(ctx context.Context) error {
 
 func (cq *CharacterQuery) sqlAll(ctx context.Context) ([]*Character, error) {
 	var (
-		nodes = []*Character{}
-		_spec = cq.querySpec()
+		nodes       = []*Character{}
+		_spec       = cq.querySpec()
+		loadedTypes = [1]bool{
+			cq.withVersions != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		node := &Character{config: cq.config}
@@ -324,6 +365,7 @@ func (cq *CharacterQuery) sqlAll(ctx context.Context) ([]*Character, error) {
 			return fmt.Errorf("ent: Assign called without calling ScanValues")
 		}
 		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	if err := sqlgraph.QueryNodes(ctx, cq.driver, _spec); err != nil {
@@ -332,6 +374,32 @@ func (cq *CharacterQuery) sqlAll(ctx context.Context) ([]*Character, error) {
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+
+	if query := cq.withVersions; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*Character)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Versions = []*CharacterVersion{}
+		}
+		query.Where(predicate.CharacterVersion(func(s *sql.Selector) {
+			s.Where(sql.InValues(character.VersionsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.CharacterID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "character_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Versions = append(node.Edges.Versions, n)
+		}
+	}
+
 	return nodes, nil
 }
 
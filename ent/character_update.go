@@ -6,11 +6,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 	"github.com/msrevive/nexus2/ent/character"
+	"github.com/msrevive/nexus2/ent/characterversion"
 	"github.com/msrevive/nexus2/ent/predicate"
 )
 
@@ -81,17 +84,133 @@ func (cu *CharacterUpdate) SetData(s string) *CharacterUpdate {
 	return cu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (cu *CharacterUpdate) SetDeletedAt(t time.Time) *CharacterUpdate {
+	cu.mutation.SetDeletedAt(t)
+	return cu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cu *CharacterUpdate) SetNillableDeletedAt(t *time.Time) *CharacterUpdate {
+	if t != nil {
+		cu.SetDeletedAt(*t)
+	}
+	return cu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cu *CharacterUpdate) ClearDeletedAt() *CharacterUpdate {
+	cu.mutation.ClearDeletedAt()
+	return cu
+}
+
+// SetLive sets the "live" field.
+func (cu *CharacterUpdate) SetLive(b bool) *CharacterUpdate {
+	cu.mutation.SetLive(b)
+	return cu
+}
+
+// SetNillableLive sets the "live" field if the given value is not nil.
+func (cu *CharacterUpdate) SetNillableLive(b *bool) *CharacterUpdate {
+	if b != nil {
+		cu.SetLive(*b)
+	}
+	return cu
+}
+
+// ClearLive clears the value of the "live" field.
+func (cu *CharacterUpdate) ClearLive() *CharacterUpdate {
+	cu.mutation.ClearLive()
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CharacterUpdate) SetUpdatedAt(t time.Time) *CharacterUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (cu *CharacterUpdate) ClearUpdatedAt() *CharacterUpdate {
+	cu.mutation.ClearUpdatedAt()
+	return cu
+}
+
+// SetVersion sets the "version" field.
+func (cu *CharacterUpdate) SetVersion(i int) *CharacterUpdate {
+	cu.mutation.ResetVersion()
+	cu.mutation.SetVersion(i)
+	return cu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (cu *CharacterUpdate) SetNillableVersion(i *int) *CharacterUpdate {
+	if i != nil {
+		cu.SetVersion(*i)
+	}
+	return cu
+}
+
+// AddVersion adds i to the "version" field.
+func (cu *CharacterUpdate) AddVersion(i int) *CharacterUpdate {
+	cu.mutation.AddVersion(i)
+	return cu
+}
+
+// ClearVersion clears the value of the "version" field.
+func (cu *CharacterUpdate) ClearVersion() *CharacterUpdate {
+	cu.mutation.ClearVersion()
+	return cu
+}
+
+// AddVersionIDs adds the "versions" edge to the CharacterVersion entity by IDs.
+func (cu *CharacterUpdate) AddVersionIDs(ids ...uuid.UUID) *CharacterUpdate {
+	cu.mutation.AddVersionIDs(ids...)
+	return cu
+}
+
+// AddVersions adds the "versions" edges to the CharacterVersion entity.
+func (cu *CharacterUpdate) AddVersions(c ...*CharacterVersion) *CharacterUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddVersionIDs(ids...)
+}
+
 // Mutation returns the CharacterMutation object of the builder.
 func (cu *CharacterUpdate) Mutation() *CharacterMutation {
 	return cu.mutation
 }
 
+// ClearVersions clears all "versions" edges to the CharacterVersion entity.
+func (cu *CharacterUpdate) ClearVersions() *CharacterUpdate {
+	cu.mutation.ClearVersions()
+	return cu
+}
+
+// RemoveVersionIDs removes the "versions" edge to CharacterVersion entities by IDs.
+func (cu *CharacterUpdate) RemoveVersionIDs(ids ...uuid.UUID) *CharacterUpdate {
+	cu.mutation.RemoveVersionIDs(ids...)
+	return cu
+}
+
+// RemoveVersions removes "versions" edges to CharacterVersion entities.
+func (cu *CharacterUpdate) RemoveVersions(c ...*CharacterVersion) *CharacterUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveVersionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *CharacterUpdate) Save(ctx context.Context) (int, error) {
 	var (
 		err      error
 		affected int
 	)
+	cu.defaults()
 	if len(cu.hooks) == 0 {
 		if err = cu.check(); err != nil {
 			return 0, err
@@ -146,6 +265,14 @@ func (cu *CharacterUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (cu *CharacterUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok && !cu.mutation.UpdatedAtCleared() {
+		v := character.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (cu *CharacterUpdate) check() error {
 	if v, ok := cu.mutation.Slot(); ok {
@@ -216,6 +343,125 @@ func (cu *CharacterUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: character.FieldData,
 		})
 	}
+	if value, ok := cu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: character.FieldDeletedAt,
+		})
+	}
+	if cu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: character.FieldDeletedAt,
+		})
+	}
+	if value, ok := cu.mutation.Live(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: character.FieldLive,
+		})
+	}
+	if cu.mutation.LiveCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Column: character.FieldLive,
+		})
+	}
+	if cu.mutation.CreatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: character.FieldCreatedAt,
+		})
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: character.FieldUpdatedAt,
+		})
+	}
+	if cu.mutation.UpdatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: character.FieldUpdatedAt,
+		})
+	}
+	if value, ok := cu.mutation.Version(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: character.FieldVersion,
+		})
+	}
+	if value, ok := cu.mutation.AddedVersion(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: character.FieldVersion,
+		})
+	}
+	if cu.mutation.VersionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: character.FieldVersion,
+		})
+	}
+	if cu.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   character.VersionsTable,
+			Columns: []string{character.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: characterversion.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedVersionsIDs(); len(nodes) > 0 && !cu.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   character.VersionsTable,
+			Columns: []string{character.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: characterversion.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   character.VersionsTable,
+			Columns: []string{character.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: characterversion.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{character.Label}
@@ -289,11 +535,126 @@ func (cuo *CharacterUpdateOne) SetData(s string) *CharacterUpdateOne {
 	return cuo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (cuo *CharacterUpdateOne) SetDeletedAt(t time.Time) *CharacterUpdateOne {
+	cuo.mutation.SetDeletedAt(t)
+	return cuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cuo *CharacterUpdateOne) SetNillableDeletedAt(t *time.Time) *CharacterUpdateOne {
+	if t != nil {
+		cuo.SetDeletedAt(*t)
+	}
+	return cuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cuo *CharacterUpdateOne) ClearDeletedAt() *CharacterUpdateOne {
+	cuo.mutation.ClearDeletedAt()
+	return cuo
+}
+
+// SetLive sets the "live" field.
+func (cuo *CharacterUpdateOne) SetLive(b bool) *CharacterUpdateOne {
+	cuo.mutation.SetLive(b)
+	return cuo
+}
+
+// SetNillableLive sets the "live" field if the given value is not nil.
+func (cuo *CharacterUpdateOne) SetNillableLive(b *bool) *CharacterUpdateOne {
+	if b != nil {
+		cuo.SetLive(*b)
+	}
+	return cuo
+}
+
+// ClearLive clears the value of the "live" field.
+func (cuo *CharacterUpdateOne) ClearLive() *CharacterUpdateOne {
+	cuo.mutation.ClearLive()
+	return cuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CharacterUpdateOne) SetUpdatedAt(t time.Time) *CharacterUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (cuo *CharacterUpdateOne) ClearUpdatedAt() *CharacterUpdateOne {
+	cuo.mutation.ClearUpdatedAt()
+	return cuo
+}
+
+// SetVersion sets the "version" field.
+func (cuo *CharacterUpdateOne) SetVersion(i int) *CharacterUpdateOne {
+	cuo.mutation.ResetVersion()
+	cuo.mutation.SetVersion(i)
+	return cuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (cuo *CharacterUpdateOne) SetNillableVersion(i *int) *CharacterUpdateOne {
+	if i != nil {
+		cuo.SetVersion(*i)
+	}
+	return cuo
+}
+
+// AddVersion adds i to the "version" field.
+func (cuo *CharacterUpdateOne) AddVersion(i int) *CharacterUpdateOne {
+	cuo.mutation.AddVersion(i)
+	return cuo
+}
+
+// ClearVersion clears the value of the "version" field.
+func (cuo *CharacterUpdateOne) ClearVersion() *CharacterUpdateOne {
+	cuo.mutation.ClearVersion()
+	return cuo
+}
+
+// AddVersionIDs adds the "versions" edge to the CharacterVersion entity by IDs.
+func (cuo *CharacterUpdateOne) AddVersionIDs(ids ...uuid.UUID) *CharacterUpdateOne {
+	cuo.mutation.AddVersionIDs(ids...)
+	return cuo
+}
+
+// AddVersions adds the "versions" edges to the CharacterVersion entity.
+func (cuo *CharacterUpdateOne) AddVersions(c ...*CharacterVersion) *CharacterUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddVersionIDs(ids...)
+}
+
 // Mutation returns the CharacterMutation object of the builder.
 func (cuo *CharacterUpdateOne) Mutation() *CharacterMutation {
 	return cuo.mutation
 }
 
+// ClearVersions clears all "versions" edges to the CharacterVersion entity.
+func (cuo *CharacterUpdateOne) ClearVersions() *CharacterUpdateOne {
+	cuo.mutation.ClearVersions()
+	return cuo
+}
+
+// RemoveVersionIDs removes the "versions" edge to CharacterVersion entities by IDs.
+func (cuo *CharacterUpdateOne) RemoveVersionIDs(ids ...uuid.UUID) *CharacterUpdateOne {
+	cuo.mutation.RemoveVersionIDs(ids...)
+	return cuo
+}
+
+// RemoveVersions removes "versions" edges to CharacterVersion entities.
+func (cuo *CharacterUpdateOne) RemoveVersions(c ...*CharacterVersion) *CharacterUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveVersionIDs(ids...)
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (cuo *CharacterUpdateOne) Select(field string, fields ...string) *CharacterUpdateOne {
@@ -307,6 +668,7 @@ func (cuo *CharacterUpdateOne) Save(ctx context.Context) (*Character, error) {
 		err  error
 		node *Character
 	)
+	cuo.defaults()
 	if len(cuo.hooks) == 0 {
 		if err = cuo.check(); err != nil {
 			return nil, err
@@ -361,6 +723,14 @@ func (cuo *CharacterUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (cuo *CharacterUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok && !cuo.mutation.UpdatedAtCleared() {
+		v := character.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (cuo *CharacterUpdateOne) check() error {
 	if v, ok := cuo.mutation.Slot(); ok {
@@ -448,6 +818,125 @@ func (cuo *CharacterUpdateOne) sqlSave(ctx context.Context) (_node *Character, e
 			Column: character.FieldData,
 		})
 	}
+	if value, ok := cuo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: character.FieldDeletedAt,
+		})
+	}
+	if cuo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: character.FieldDeletedAt,
+		})
+	}
+	if value, ok := cuo.mutation.Live(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: character.FieldLive,
+		})
+	}
+	if cuo.mutation.LiveCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Column: character.FieldLive,
+		})
+	}
+	if cuo.mutation.CreatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: character.FieldCreatedAt,
+		})
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: character.FieldUpdatedAt,
+		})
+	}
+	if cuo.mutation.UpdatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: character.FieldUpdatedAt,
+		})
+	}
+	if value, ok := cuo.mutation.Version(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: character.FieldVersion,
+		})
+	}
+	if value, ok := cuo.mutation.AddedVersion(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: character.FieldVersion,
+		})
+	}
+	if cuo.mutation.VersionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: character.FieldVersion,
+		})
+	}
+	if cuo.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   character.VersionsTable,
+			Columns: []string{character.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: characterversion.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedVersionsIDs(); len(nodes) > 0 && !cuo.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   character.VersionsTable,
+			Columns: []string{character.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: characterversion.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   character.VersionsTable,
+			Columns: []string{character.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: characterversion.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Character{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,133 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/characterlock"
+)
+
+// CharacterLock is the model entity for the CharacterLock schema.
+type CharacterLock struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CharacterID holds the value of the "character_id" field.
+	CharacterID uuid.UUID `json:"character_id,omitempty"`
+	// Server holds the value of the "server" field.
+	Server string `json:"server,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CharacterLock) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case characterlock.FieldServer:
+			values[i] = new(sql.NullString)
+		case characterlock.FieldExpiresAt, characterlock.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case characterlock.FieldID, characterlock.FieldCharacterID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type CharacterLock", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CharacterLock fields.
+func (cl *CharacterLock) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case characterlock.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cl.ID = *value
+			}
+		case characterlock.FieldCharacterID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field character_id", values[i])
+			} else if value != nil {
+				cl.CharacterID = *value
+			}
+		case characterlock.FieldServer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field server", values[i])
+			} else if value.Valid {
+				cl.Server = value.String
+			}
+		case characterlock.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				cl.ExpiresAt = value.Time
+			}
+		case characterlock.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cl.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this CharacterLock.
+// Note that you need to call CharacterLock.Unwrap() before calling this method if this CharacterLock
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cl *CharacterLock) Update() *CharacterLockUpdateOne {
+	return (&CharacterLockClient{config: cl.config}).UpdateOne(cl)
+}
+
+// Unwrap unwraps the CharacterLock entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cl *CharacterLock) Unwrap() *CharacterLock {
+	tx, ok := cl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CharacterLock is not a transactional entity")
+	}
+	cl.config.driver = tx.drv
+	return cl
+}
+
+// String implements the fmt.Stringer.
+func (cl *CharacterLock) String() string {
+	var builder strings.Builder
+	builder.WriteString("CharacterLock(")
+	builder.WriteString(fmt.Sprintf("id=%v", cl.ID))
+	builder.WriteString(", character_id=")
+	builder.WriteString(fmt.Sprintf("%v", cl.CharacterID))
+	builder.WriteString(", server=")
+	builder.WriteString(cl.Server)
+	builder.WriteString(", expires_at=")
+	builder.WriteString(cl.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", created_at=")
+	builder.WriteString(cl.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CharacterLocks is a parsable slice of CharacterLock.
+type CharacterLocks []*CharacterLock
+
+func (cl CharacterLocks) config(cfg config) {
+	for _i := range cl {
+		cl[_i].config = cfg
+	}
+}
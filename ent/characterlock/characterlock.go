@@ -0,0 +1,54 @@
+// Code generated by entc, DO NOT EDIT.
+
+package characterlock
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the characterlock type in the database.
+	Label = "character_lock"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCharacterID holds the string denoting the character_id field in the database.
+	FieldCharacterID = "character_id"
+	// FieldServer holds the string denoting the server field in the database.
+	FieldServer = "server"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the characterlock in the database.
+	Table = "character_locks"
+)
+
+// Columns holds all SQL columns for characterlock fields.
+var Columns = []string{
+	FieldID,
+	FieldCharacterID,
+	FieldServer,
+	FieldExpiresAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ServerValidator is a validator for the "server" field. It is called by the builders before save.
+	ServerValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
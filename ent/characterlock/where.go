@@ -0,0 +1,493 @@
+// Code generated by entc, DO NOT EDIT.
+
+package characterlock
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CharacterID applies equality check predicate on the "character_id" field. It's identical to CharacterIDEQ.
+func CharacterID(v uuid.UUID) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCharacterID), v))
+	})
+}
+
+// Server applies equality check predicate on the "server" field. It's identical to ServerEQ.
+func Server(v string) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldServer), v))
+	})
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CharacterIDEQ applies the EQ predicate on the "character_id" field.
+func CharacterIDEQ(v uuid.UUID) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCharacterID), v))
+	})
+}
+
+// CharacterIDNEQ applies the NEQ predicate on the "character_id" field.
+func CharacterIDNEQ(v uuid.UUID) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCharacterID), v))
+	})
+}
+
+// CharacterIDIn applies the In predicate on the "character_id" field.
+func CharacterIDIn(vs ...uuid.UUID) predicate.CharacterLock {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCharacterID), v...))
+	})
+}
+
+// CharacterIDNotIn applies the NotIn predicate on the "character_id" field.
+func CharacterIDNotIn(vs ...uuid.UUID) predicate.CharacterLock {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCharacterID), v...))
+	})
+}
+
+// CharacterIDGT applies the GT predicate on the "character_id" field.
+func CharacterIDGT(v uuid.UUID) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCharacterID), v))
+	})
+}
+
+// CharacterIDGTE applies the GTE predicate on the "character_id" field.
+func CharacterIDGTE(v uuid.UUID) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCharacterID), v))
+	})
+}
+
+// CharacterIDLT applies the LT predicate on the "character_id" field.
+func CharacterIDLT(v uuid.UUID) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCharacterID), v))
+	})
+}
+
+// CharacterIDLTE applies the LTE predicate on the "character_id" field.
+func CharacterIDLTE(v uuid.UUID) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCharacterID), v))
+	})
+}
+
+// ServerEQ applies the EQ predicate on the "server" field.
+func ServerEQ(v string) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldServer), v))
+	})
+}
+
+// ServerNEQ applies the NEQ predicate on the "server" field.
+func ServerNEQ(v string) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldServer), v))
+	})
+}
+
+// ServerIn applies the In predicate on the "server" field.
+func ServerIn(vs ...string) predicate.CharacterLock {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldServer), v...))
+	})
+}
+
+// ServerNotIn applies the NotIn predicate on the "server" field.
+func ServerNotIn(vs ...string) predicate.CharacterLock {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldServer), v...))
+	})
+}
+
+// ServerGT applies the GT predicate on the "server" field.
+func ServerGT(v string) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldServer), v))
+	})
+}
+
+// ServerGTE applies the GTE predicate on the "server" field.
+func ServerGTE(v string) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldServer), v))
+	})
+}
+
+// ServerLT applies the LT predicate on the "server" field.
+func ServerLT(v string) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldServer), v))
+	})
+}
+
+// ServerLTE applies the LTE predicate on the "server" field.
+func ServerLTE(v string) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldServer), v))
+	})
+}
+
+// ServerContains applies the Contains predicate on the "server" field.
+func ServerContains(v string) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldServer), v))
+	})
+}
+
+// ServerHasPrefix applies the HasPrefix predicate on the "server" field.
+func ServerHasPrefix(v string) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldServer), v))
+	})
+}
+
+// ServerHasSuffix applies the HasSuffix predicate on the "server" field.
+func ServerHasSuffix(v string) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldServer), v))
+	})
+}
+
+// ServerEqualFold applies the EqualFold predicate on the "server" field.
+func ServerEqualFold(v string) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldServer), v))
+	})
+}
+
+// ServerContainsFold applies the ContainsFold predicate on the "server" field.
+func ServerContainsFold(v string) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldServer), v))
+	})
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.CharacterLock {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.CharacterLock {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CharacterLock {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CharacterLock {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CharacterLock) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CharacterLock) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CharacterLock) predicate.CharacterLock {
+	return predicate.CharacterLock(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
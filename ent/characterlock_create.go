@@ -0,0 +1,319 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/characterlock"
+)
+
+// CharacterLockCreate is the builder for creating a CharacterLock entity.
+type CharacterLockCreate struct {
+	config
+	mutation *CharacterLockMutation
+	hooks    []Hook
+}
+
+// SetCharacterID sets the "character_id" field.
+func (clc *CharacterLockCreate) SetCharacterID(u uuid.UUID) *CharacterLockCreate {
+	clc.mutation.SetCharacterID(u)
+	return clc
+}
+
+// SetServer sets the "server" field.
+func (clc *CharacterLockCreate) SetServer(s string) *CharacterLockCreate {
+	clc.mutation.SetServer(s)
+	return clc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (clc *CharacterLockCreate) SetExpiresAt(t time.Time) *CharacterLockCreate {
+	clc.mutation.SetExpiresAt(t)
+	return clc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (clc *CharacterLockCreate) SetCreatedAt(t time.Time) *CharacterLockCreate {
+	clc.mutation.SetCreatedAt(t)
+	return clc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (clc *CharacterLockCreate) SetNillableCreatedAt(t *time.Time) *CharacterLockCreate {
+	if t != nil {
+		clc.SetCreatedAt(*t)
+	}
+	return clc
+}
+
+// SetID sets the "id" field.
+func (clc *CharacterLockCreate) SetID(u uuid.UUID) *CharacterLockCreate {
+	clc.mutation.SetID(u)
+	return clc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (clc *CharacterLockCreate) SetNillableID(u *uuid.UUID) *CharacterLockCreate {
+	if u != nil {
+		clc.SetID(*u)
+	}
+	return clc
+}
+
+// Mutation returns the CharacterLockMutation object of the builder.
+func (clc *CharacterLockCreate) Mutation() *CharacterLockMutation {
+	return clc.mutation
+}
+
+// Save creates the CharacterLock in the database.
+func (clc *CharacterLockCreate) Save(ctx context.Context) (*CharacterLock, error) {
+	var (
+		err  error
+		node *CharacterLock
+	)
+	clc.defaults()
+	if len(clc.hooks) == 0 {
+		if err = clc.check(); err != nil {
+			return nil, err
+		}
+		node, err = clc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CharacterLockMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = clc.check(); err != nil {
+				return nil, err
+			}
+			clc.mutation = mutation
+			if node, err = clc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(clc.hooks) - 1; i >= 0; i-- {
+			if clc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = clc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, clc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (clc *CharacterLockCreate) SaveX(ctx context.Context) *CharacterLock {
+	v, err := clc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (clc *CharacterLockCreate) Exec(ctx context.Context) error {
+	_, err := clc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (clc *CharacterLockCreate) ExecX(ctx context.Context) {
+	if err := clc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (clc *CharacterLockCreate) defaults() {
+	if _, ok := clc.mutation.CreatedAt(); !ok {
+		v := characterlock.DefaultCreatedAt()
+		clc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := clc.mutation.ID(); !ok {
+		v := characterlock.DefaultID()
+		clc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (clc *CharacterLockCreate) check() error {
+	if _, ok := clc.mutation.CharacterID(); !ok {
+		return &ValidationError{Name: "character_id", err: errors.New(`ent: missing required field "CharacterLock.character_id"`)}
+	}
+	if _, ok := clc.mutation.Server(); !ok {
+		return &ValidationError{Name: "server", err: errors.New(`ent: missing required field "CharacterLock.server"`)}
+	}
+	if v, ok := clc.mutation.Server(); ok {
+		if err := characterlock.ServerValidator(v); err != nil {
+			return &ValidationError{Name: "server", err: fmt.Errorf(`ent: validator failed for field "CharacterLock.server": %w`, err)}
+		}
+	}
+	if _, ok := clc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "CharacterLock.expires_at"`)}
+	}
+	if _, ok := clc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CharacterLock.created_at"`)}
+	}
+	return nil
+}
+
+func (clc *CharacterLockCreate) sqlSave(ctx context.Context) (*CharacterLock, error) {
+	_node, _spec := clc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, clc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (clc *CharacterLockCreate) createSpec() (*CharacterLock, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CharacterLock{config: clc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: characterlock.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: characterlock.FieldID,
+			},
+		}
+	)
+	if id, ok := clc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := clc.mutation.CharacterID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: characterlock.FieldCharacterID,
+		})
+		_node.CharacterID = value
+	}
+	if value, ok := clc.mutation.Server(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: characterlock.FieldServer,
+		})
+		_node.Server = value
+	}
+	if value, ok := clc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: characterlock.FieldExpiresAt,
+		})
+		_node.ExpiresAt = value
+	}
+	if value, ok := clc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: characterlock.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// CharacterLockCreateBulk is the builder for creating many CharacterLock entities in bulk.
+type CharacterLockCreateBulk struct {
+	config
+	builders []*CharacterLockCreate
+}
+
+// Save creates the CharacterLock entities in the database.
+func (clcb *CharacterLockCreateBulk) Save(ctx context.Context) ([]*CharacterLock, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(clcb.builders))
+	nodes := make([]*CharacterLock, len(clcb.builders))
+	mutators := make([]Mutator, len(clcb.builders))
+	for i := range clcb.builders {
+		func(i int, root context.Context) {
+			builder := clcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CharacterLockMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, clcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, clcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, clcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (clcb *CharacterLockCreateBulk) SaveX(ctx context.Context) []*CharacterLock {
+	v, err := clcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (clcb *CharacterLockCreateBulk) Exec(ctx context.Context) error {
+	_, err := clcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (clcb *CharacterLockCreateBulk) ExecX(ctx context.Context) {
+	if err := clcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
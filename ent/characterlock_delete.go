@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/msrevive/nexus2/ent/characterlock"
+	"github.com/msrevive/nexus2/ent/predicate"
+)
+
+// CharacterLockDelete is the builder for deleting a CharacterLock entity.
+type CharacterLockDelete struct {
+	config
+	hooks    []Hook
+	mutation *CharacterLockMutation
+}
+
+// Where appends a list predicates to the CharacterLockDelete builder.
+func (cld *CharacterLockDelete) Where(ps ...predicate.CharacterLock) *CharacterLockDelete {
+	cld.mutation.Where(ps...)
+	return cld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cld *CharacterLockDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cld.hooks) == 0 {
+		affected, err = cld.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CharacterLockMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cld.mutation = mutation
+			affected, err = cld.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cld.hooks) - 1; i >= 0; i-- {
+			if cld.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cld.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cld.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cld *CharacterLockDelete) ExecX(ctx context.Context) int {
+	n, err := cld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cld *CharacterLockDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: characterlock.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: characterlock.FieldID,
+			},
+		},
+	}
+	if ps := cld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, cld.driver, _spec)
+}
+
+// CharacterLockDeleteOne is the builder for deleting a single CharacterLock entity.
+type CharacterLockDeleteOne struct {
+	cld *CharacterLockDelete
+}
+
+// Exec executes the deletion query.
+func (cldo *CharacterLockDeleteOne) Exec(ctx context.Context) error {
+	n, err := cldo.cld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{characterlock.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cldo *CharacterLockDeleteOne) ExecX(ctx context.Context) {
+	cldo.cld.ExecX(ctx)
+}
@@ -0,0 +1,921 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/characterlock"
+	"github.com/msrevive/nexus2/ent/predicate"
+)
+
+// CharacterLockQuery is the builder for querying CharacterLock entities.
+type CharacterLockQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.CharacterLock
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CharacterLockQuery builder.
+func (clq *CharacterLockQuery) Where(ps ...predicate.CharacterLock) *CharacterLockQuery {
+	clq.predicates = append(clq.predicates, ps...)
+	return clq
+}
+
+// Limit adds a limit step to the query.
+func (clq *CharacterLockQuery) Limit(limit int) *CharacterLockQuery {
+	clq.limit = &limit
+	return clq
+}
+
+// Offset adds an offset step to the query.
+func (clq *CharacterLockQuery) Offset(offset int) *CharacterLockQuery {
+	clq.offset = &offset
+	return clq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (clq *CharacterLockQuery) Unique(unique bool) *CharacterLockQuery {
+	clq.unique = &unique
+	return clq
+}
+
+// Order adds an order step to the query.
+func (clq *CharacterLockQuery) Order(o ...OrderFunc) *CharacterLockQuery {
+	clq.order = append(clq.order, o...)
+	return clq
+}
+
+// First returns the first CharacterLock entity from the query.
+// Returns a *NotFoundError when no CharacterLock was found.
+func (clq *CharacterLockQuery) First(ctx context.Context) (*CharacterLock, error) {
+	nodes, err := clq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{characterlock.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (clq *CharacterLockQuery) FirstX(ctx context.Context) *CharacterLock {
+	node, err := clq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CharacterLock ID from the query.
+// Returns a *NotFoundError when no CharacterLock ID was found.
+func (clq *CharacterLockQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = clq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{characterlock.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (clq *CharacterLockQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := clq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CharacterLock entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CharacterLock entity is found.
+// Returns a *NotFoundError when no CharacterLock entities are found.
+func (clq *CharacterLockQuery) Only(ctx context.Context) (*CharacterLock, error) {
+	nodes, err := clq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{characterlock.Label}
+	default:
+		return nil, &NotSingularError{characterlock.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (clq *CharacterLockQuery) OnlyX(ctx context.Context) *CharacterLock {
+	node, err := clq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CharacterLock ID in the query.
+// Returns a *NotSingularError when more than one CharacterLock ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (clq *CharacterLockQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = clq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{characterlock.Label}
+	default:
+		err = &NotSingularError{characterlock.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (clq *CharacterLockQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := clq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CharacterLocks.
+func (clq *CharacterLockQuery) All(ctx context.Context) ([]*CharacterLock, error) {
+	if err := clq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return clq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (clq *CharacterLockQuery) AllX(ctx context.Context) []*CharacterLock {
+	nodes, err := clq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CharacterLock IDs.
+func (clq *CharacterLockQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := clq.Select(characterlock.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (clq *CharacterLockQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := clq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (clq *CharacterLockQuery) Count(ctx context.Context) (int, error) {
+	if err := clq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return clq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (clq *CharacterLockQuery) CountX(ctx context.Context) int {
+	count, err := clq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (clq *CharacterLockQuery) Exist(ctx context.Context) (bool, error) {
+	if err := clq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return clq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (clq *CharacterLockQuery) ExistX(ctx context.Context) bool {
+	exist, err := clq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CharacterLockQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (clq *CharacterLockQuery) Clone() *CharacterLockQuery {
+	if clq == nil {
+		return nil
+	}
+	return &CharacterLockQuery{
+		config:     clq.config,
+		limit:      clq.limit,
+		offset:     clq.offset,
+		order:      append([]OrderFunc{}, clq.order...),
+		predicates: append([]predicate.CharacterLock{}, clq.predicates...),
+		// clone intermediate query.
+		sql:  clq.sql.Clone(),
+		path: clq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CharacterID uuid.UUID `json:"character_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CharacterLock.Query().
+//		GroupBy(characterlock.FieldCharacterID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (clq *CharacterLockQuery) GroupBy(field string, fields ...string) *CharacterLockGroupBy {
+	group := &CharacterLockGroupBy{config: clq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := clq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return clq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CharacterID uuid.UUID `json:"character_id,omitempty"`
+//	}
+//
+//	client.CharacterLock.Query().
+//		Select(characterlock.FieldCharacterID).
+//		Scan(ctx, &v)
+//
+func (clq *CharacterLockQuery) Select(fields ...string) *CharacterLockSelect {
+	clq.fields = append(clq.fields, fields...)
+	return &CharacterLockSelect{CharacterLockQuery: clq}
+}
+
+func (clq *CharacterLockQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range clq.fields {
+		if !characterlock.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if clq.path != nil {
+		prev, err := clq.path(ctx)
+		if err != nil {
+			return err
+		}
+		clq.sql = prev
+	}
+	return nil
+}
+
+func (clq *CharacterLockQuery) sqlAll(ctx context.Context) ([]*CharacterLock, error) {
+	var (
+		nodes = []*CharacterLock{}
+		_spec = clq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &CharacterLock{config: clq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, clq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (clq *CharacterLockQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := clq.querySpec()
+	_spec.Node.Columns = clq.fields
+	if len(clq.fields) > 0 {
+		_spec.Unique = clq.unique != nil && *clq.unique
+	}
+	return sqlgraph.CountNodes(ctx, clq.driver, _spec)
+}
+
+func (clq *CharacterLockQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := clq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (clq *CharacterLockQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   characterlock.Table,
+			Columns: characterlock.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: characterlock.FieldID,
+			},
+		},
+		From:   clq.sql,
+		Unique: true,
+	}
+	if unique := clq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := clq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, characterlock.FieldID)
+		for i := range fields {
+			if fields[i] != characterlock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := clq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := clq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := clq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := clq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (clq *CharacterLockQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(clq.driver.Dialect())
+	t1 := builder.Table(characterlock.Table)
+	columns := clq.fields
+	if len(columns) == 0 {
+		columns = characterlock.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if clq.sql != nil {
+		selector = clq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if clq.unique != nil && *clq.unique {
+		selector.Distinct()
+	}
+	for _, p := range clq.predicates {
+		p(selector)
+	}
+	for _, p := range clq.order {
+		p(selector)
+	}
+	if offset := clq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := clq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CharacterLockGroupBy is the group-by builder for CharacterLock entities.
+type CharacterLockGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (clgb *CharacterLockGroupBy) Aggregate(fns ...AggregateFunc) *CharacterLockGroupBy {
+	clgb.fns = append(clgb.fns, fns...)
+	return clgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (clgb *CharacterLockGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := clgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	clgb.sql = query
+	return clgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (clgb *CharacterLockGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := clgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (clgb *CharacterLockGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(clgb.fields) > 1 {
+		return nil, errors.New("ent: CharacterLockGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := clgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (clgb *CharacterLockGroupBy) StringsX(ctx context.Context) []string {
+	v, err := clgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (clgb *CharacterLockGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = clgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{characterlock.Label}
+	default:
+		err = fmt.Errorf("ent: CharacterLockGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (clgb *CharacterLockGroupBy) StringX(ctx context.Context) string {
+	v, err := clgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (clgb *CharacterLockGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(clgb.fields) > 1 {
+		return nil, errors.New("ent: CharacterLockGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := clgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (clgb *CharacterLockGroupBy) IntsX(ctx context.Context) []int {
+	v, err := clgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (clgb *CharacterLockGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = clgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{characterlock.Label}
+	default:
+		err = fmt.Errorf("ent: CharacterLockGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (clgb *CharacterLockGroupBy) IntX(ctx context.Context) int {
+	v, err := clgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (clgb *CharacterLockGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(clgb.fields) > 1 {
+		return nil, errors.New("ent: CharacterLockGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := clgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (clgb *CharacterLockGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := clgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (clgb *CharacterLockGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = clgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{characterlock.Label}
+	default:
+		err = fmt.Errorf("ent: CharacterLockGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (clgb *CharacterLockGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := clgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (clgb *CharacterLockGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(clgb.fields) > 1 {
+		return nil, errors.New("ent: CharacterLockGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := clgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (clgb *CharacterLockGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := clgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (clgb *CharacterLockGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = clgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{characterlock.Label}
+	default:
+		err = fmt.Errorf("ent: CharacterLockGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (clgb *CharacterLockGroupBy) BoolX(ctx context.Context) bool {
+	v, err := clgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (clgb *CharacterLockGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range clgb.fields {
+		if !characterlock.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := clgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := clgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (clgb *CharacterLockGroupBy) sqlQuery() *sql.Selector {
+	selector := clgb.sql.Select()
+	aggregation := make([]string, 0, len(clgb.fns))
+	for _, fn := range clgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(clgb.fields)+len(clgb.fns))
+		for _, f := range clgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(clgb.fields...)...)
+}
+
+// CharacterLockSelect is the builder for selecting fields of CharacterLock entities.
+type CharacterLockSelect struct {
+	*CharacterLockQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cls *CharacterLockSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := cls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	cls.sql = cls.CharacterLockQuery.sqlQuery(ctx)
+	return cls.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cls *CharacterLockSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := cls.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (cls *CharacterLockSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(cls.fields) > 1 {
+		return nil, errors.New("ent: CharacterLockSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := cls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cls *CharacterLockSelect) StringsX(ctx context.Context) []string {
+	v, err := cls.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (cls *CharacterLockSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cls.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{characterlock.Label}
+	default:
+		err = fmt.Errorf("ent: CharacterLockSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cls *CharacterLockSelect) StringX(ctx context.Context) string {
+	v, err := cls.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (cls *CharacterLockSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(cls.fields) > 1 {
+		return nil, errors.New("ent: CharacterLockSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := cls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cls *CharacterLockSelect) IntsX(ctx context.Context) []int {
+	v, err := cls.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (cls *CharacterLockSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cls.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{characterlock.Label}
+	default:
+		err = fmt.Errorf("ent: CharacterLockSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cls *CharacterLockSelect) IntX(ctx context.Context) int {
+	v, err := cls.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (cls *CharacterLockSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cls.fields) > 1 {
+		return nil, errors.New("ent: CharacterLockSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := cls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cls *CharacterLockSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := cls.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (cls *CharacterLockSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cls.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{characterlock.Label}
+	default:
+		err = fmt.Errorf("ent: CharacterLockSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cls *CharacterLockSelect) Float64X(ctx context.Context) float64 {
+	v, err := cls.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (cls *CharacterLockSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(cls.fields) > 1 {
+		return nil, errors.New("ent: CharacterLockSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := cls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cls *CharacterLockSelect) BoolsX(ctx context.Context) []bool {
+	v, err := cls.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (cls *CharacterLockSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cls.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{characterlock.Label}
+	default:
+		err = fmt.Errorf("ent: CharacterLockSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cls *CharacterLockSelect) BoolX(ctx context.Context) bool {
+	v, err := cls.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cls *CharacterLockSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := cls.sql.Query()
+	if err := cls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
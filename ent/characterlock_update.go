@@ -0,0 +1,393 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/characterlock"
+	"github.com/msrevive/nexus2/ent/predicate"
+)
+
+// CharacterLockUpdate is the builder for updating CharacterLock entities.
+type CharacterLockUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CharacterLockMutation
+}
+
+// Where appends a list predicates to the CharacterLockUpdate builder.
+func (clu *CharacterLockUpdate) Where(ps ...predicate.CharacterLock) *CharacterLockUpdate {
+	clu.mutation.Where(ps...)
+	return clu
+}
+
+// SetCharacterID sets the "character_id" field.
+func (clu *CharacterLockUpdate) SetCharacterID(u uuid.UUID) *CharacterLockUpdate {
+	clu.mutation.SetCharacterID(u)
+	return clu
+}
+
+// SetServer sets the "server" field.
+func (clu *CharacterLockUpdate) SetServer(s string) *CharacterLockUpdate {
+	clu.mutation.SetServer(s)
+	return clu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (clu *CharacterLockUpdate) SetExpiresAt(t time.Time) *CharacterLockUpdate {
+	clu.mutation.SetExpiresAt(t)
+	return clu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (clu *CharacterLockUpdate) SetCreatedAt(t time.Time) *CharacterLockUpdate {
+	clu.mutation.SetCreatedAt(t)
+	return clu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (clu *CharacterLockUpdate) SetNillableCreatedAt(t *time.Time) *CharacterLockUpdate {
+	if t != nil {
+		clu.SetCreatedAt(*t)
+	}
+	return clu
+}
+
+// Mutation returns the CharacterLockMutation object of the builder.
+func (clu *CharacterLockUpdate) Mutation() *CharacterLockMutation {
+	return clu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (clu *CharacterLockUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(clu.hooks) == 0 {
+		if err = clu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = clu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CharacterLockMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = clu.check(); err != nil {
+				return 0, err
+			}
+			clu.mutation = mutation
+			affected, err = clu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(clu.hooks) - 1; i >= 0; i-- {
+			if clu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = clu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, clu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (clu *CharacterLockUpdate) SaveX(ctx context.Context) int {
+	affected, err := clu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (clu *CharacterLockUpdate) Exec(ctx context.Context) error {
+	_, err := clu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (clu *CharacterLockUpdate) ExecX(ctx context.Context) {
+	if err := clu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (clu *CharacterLockUpdate) check() error {
+	if v, ok := clu.mutation.Server(); ok {
+		if err := characterlock.ServerValidator(v); err != nil {
+			return &ValidationError{Name: "server", err: fmt.Errorf(`ent: validator failed for field "CharacterLock.server": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (clu *CharacterLockUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   characterlock.Table,
+			Columns: characterlock.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: characterlock.FieldID,
+			},
+		},
+	}
+	if ps := clu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := clu.mutation.CharacterID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: characterlock.FieldCharacterID,
+		})
+	}
+	if value, ok := clu.mutation.Server(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: characterlock.FieldServer,
+		})
+	}
+	if value, ok := clu.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: characterlock.FieldExpiresAt,
+		})
+	}
+	if value, ok := clu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: characterlock.FieldCreatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, clu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{characterlock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// CharacterLockUpdateOne is the builder for updating a single CharacterLock entity.
+type CharacterLockUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CharacterLockMutation
+}
+
+// SetCharacterID sets the "character_id" field.
+func (cluo *CharacterLockUpdateOne) SetCharacterID(u uuid.UUID) *CharacterLockUpdateOne {
+	cluo.mutation.SetCharacterID(u)
+	return cluo
+}
+
+// SetServer sets the "server" field.
+func (cluo *CharacterLockUpdateOne) SetServer(s string) *CharacterLockUpdateOne {
+	cluo.mutation.SetServer(s)
+	return cluo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cluo *CharacterLockUpdateOne) SetExpiresAt(t time.Time) *CharacterLockUpdateOne {
+	cluo.mutation.SetExpiresAt(t)
+	return cluo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cluo *CharacterLockUpdateOne) SetCreatedAt(t time.Time) *CharacterLockUpdateOne {
+	cluo.mutation.SetCreatedAt(t)
+	return cluo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cluo *CharacterLockUpdateOne) SetNillableCreatedAt(t *time.Time) *CharacterLockUpdateOne {
+	if t != nil {
+		cluo.SetCreatedAt(*t)
+	}
+	return cluo
+}
+
+// Mutation returns the CharacterLockMutation object of the builder.
+func (cluo *CharacterLockUpdateOne) Mutation() *CharacterLockMutation {
+	return cluo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cluo *CharacterLockUpdateOne) Select(field string, fields ...string) *CharacterLockUpdateOne {
+	cluo.fields = append([]string{field}, fields...)
+	return cluo
+}
+
+// Save executes the query and returns the updated CharacterLock entity.
+func (cluo *CharacterLockUpdateOne) Save(ctx context.Context) (*CharacterLock, error) {
+	var (
+		err  error
+		node *CharacterLock
+	)
+	if len(cluo.hooks) == 0 {
+		if err = cluo.check(); err != nil {
+			return nil, err
+		}
+		node, err = cluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CharacterLockMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cluo.check(); err != nil {
+				return nil, err
+			}
+			cluo.mutation = mutation
+			node, err = cluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cluo.hooks) - 1; i >= 0; i-- {
+			if cluo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cluo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cluo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cluo *CharacterLockUpdateOne) SaveX(ctx context.Context) *CharacterLock {
+	node, err := cluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cluo *CharacterLockUpdateOne) Exec(ctx context.Context) error {
+	_, err := cluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cluo *CharacterLockUpdateOne) ExecX(ctx context.Context) {
+	if err := cluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cluo *CharacterLockUpdateOne) check() error {
+	if v, ok := cluo.mutation.Server(); ok {
+		if err := characterlock.ServerValidator(v); err != nil {
+			return &ValidationError{Name: "server", err: fmt.Errorf(`ent: validator failed for field "CharacterLock.server": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cluo *CharacterLockUpdateOne) sqlSave(ctx context.Context) (_node *CharacterLock, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   characterlock.Table,
+			Columns: characterlock.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: characterlock.FieldID,
+			},
+		},
+	}
+	id, ok := cluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CharacterLock.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, characterlock.FieldID)
+		for _, f := range fields {
+			if !characterlock.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != characterlock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cluo.mutation.CharacterID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: characterlock.FieldCharacterID,
+		})
+	}
+	if value, ok := cluo.mutation.Server(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: characterlock.FieldServer,
+		})
+	}
+	if value, ok := cluo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: characterlock.FieldExpiresAt,
+		})
+	}
+	if value, ok := cluo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: characterlock.FieldCreatedAt,
+		})
+	}
+	_node = &CharacterLock{config: cluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{characterlock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
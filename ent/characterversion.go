@@ -0,0 +1,177 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/character"
+	"github.com/msrevive/nexus2/ent/characterversion"
+)
+
+// CharacterVersion is the model entity for the CharacterVersion schema.
+type CharacterVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CharacterID holds the value of the "character_id" field.
+	CharacterID uuid.UUID `json:"character_id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int `json:"size,omitempty"`
+	// Data holds the value of the "data" field.
+	Data string `json:"data,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CharacterVersionQuery when eager-loading is set.
+	Edges CharacterVersionEdges `json:"edges"`
+}
+
+// CharacterVersionEdges holds the relations/edges for other nodes in the graph.
+type CharacterVersionEdges struct {
+	// Character holds the value of the character edge.
+	Character *Character `json:"character,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CharacterOrErr returns the Character value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CharacterVersionEdges) CharacterOrErr() (*Character, error) {
+	if e.loadedTypes[0] {
+		if e.Character == nil {
+			// The edge character was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: character.Label}
+		}
+		return e.Character, nil
+	}
+	return nil, &NotLoadedError{edge: "character"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CharacterVersion) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case characterversion.FieldVersion, characterversion.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case characterversion.FieldData:
+			values[i] = new(sql.NullString)
+		case characterversion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case characterversion.FieldID, characterversion.FieldCharacterID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type CharacterVersion", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CharacterVersion fields.
+func (cv *CharacterVersion) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case characterversion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cv.ID = *value
+			}
+		case characterversion.FieldCharacterID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field character_id", values[i])
+			} else if value != nil {
+				cv.CharacterID = *value
+			}
+		case characterversion.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				cv.Version = int(value.Int64)
+			}
+		case characterversion.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				cv.Size = int(value.Int64)
+			}
+		case characterversion.FieldData:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value.Valid {
+				cv.Data = value.String
+			}
+		case characterversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cv.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCharacter queries the "character" edge of the CharacterVersion entity.
+func (cv *CharacterVersion) QueryCharacter() *CharacterQuery {
+	return (&CharacterVersionClient{config: cv.config}).QueryCharacter(cv)
+}
+
+// Update returns a builder for updating this CharacterVersion.
+// Note that you need to call CharacterVersion.Unwrap() before calling this method if this CharacterVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cv *CharacterVersion) Update() *CharacterVersionUpdateOne {
+	return (&CharacterVersionClient{config: cv.config}).UpdateOne(cv)
+}
+
+// Unwrap unwraps the CharacterVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cv *CharacterVersion) Unwrap() *CharacterVersion {
+	tx, ok := cv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CharacterVersion is not a transactional entity")
+	}
+	cv.config.driver = tx.drv
+	return cv
+}
+
+// String implements the fmt.Stringer.
+func (cv *CharacterVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("CharacterVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v", cv.ID))
+	builder.WriteString(", character_id=")
+	builder.WriteString(fmt.Sprintf("%v", cv.CharacterID))
+	builder.WriteString(", version=")
+	builder.WriteString(fmt.Sprintf("%v", cv.Version))
+	builder.WriteString(", size=")
+	builder.WriteString(fmt.Sprintf("%v", cv.Size))
+	builder.WriteString(", data=")
+	builder.WriteString(cv.Data)
+	builder.WriteString(", created_at=")
+	builder.WriteString(cv.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CharacterVersions is a parsable slice of CharacterVersion.
+type CharacterVersions []*CharacterVersion
+
+func (cv CharacterVersions) config(cfg config) {
+	for _i := range cv {
+		cv[_i].config = cfg
+	}
+}
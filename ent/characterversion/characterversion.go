@@ -0,0 +1,68 @@
+// Code generated by entc, DO NOT EDIT.
+
+package characterversion
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the characterversion type in the database.
+	Label = "character_version"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCharacterID holds the string denoting the character_id field in the database.
+	FieldCharacterID = "character_id"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldData holds the string denoting the data field in the database.
+	FieldData = "data"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeCharacter holds the string denoting the character edge name in mutations.
+	EdgeCharacter = "character"
+	// Table holds the table name of the characterversion in the database.
+	Table = "character_versions"
+	// CharacterTable is the table that holds the character relation/edge.
+	CharacterTable = "character_versions"
+	// CharacterInverseTable is the table name for the Character entity.
+	// It exists in this package in order to avoid circular dependency with the "character" package.
+	CharacterInverseTable = "characters"
+	// CharacterColumn is the table column denoting the character relation/edge.
+	CharacterColumn = "character_id"
+)
+
+// Columns holds all SQL columns for characterversion fields.
+var Columns = []string{
+	FieldID,
+	FieldCharacterID,
+	FieldVersion,
+	FieldSize,
+	FieldData,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	VersionValidator func(int) error
+	// DefaultSize holds the default value on creation for the "size" field.
+	DefaultSize int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
@@ -0,0 +1,369 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/character"
+	"github.com/msrevive/nexus2/ent/characterversion"
+)
+
+// CharacterVersionCreate is the builder for creating a CharacterVersion entity.
+type CharacterVersionCreate struct {
+	config
+	mutation *CharacterVersionMutation
+	hooks    []Hook
+}
+
+// SetCharacterID sets the "character_id" field.
+func (cvc *CharacterVersionCreate) SetCharacterID(u uuid.UUID) *CharacterVersionCreate {
+	cvc.mutation.SetCharacterID(u)
+	return cvc
+}
+
+// SetVersion sets the "version" field.
+func (cvc *CharacterVersionCreate) SetVersion(i int) *CharacterVersionCreate {
+	cvc.mutation.SetVersion(i)
+	return cvc
+}
+
+// SetSize sets the "size" field.
+func (cvc *CharacterVersionCreate) SetSize(i int) *CharacterVersionCreate {
+	cvc.mutation.SetSize(i)
+	return cvc
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (cvc *CharacterVersionCreate) SetNillableSize(i *int) *CharacterVersionCreate {
+	if i != nil {
+		cvc.SetSize(*i)
+	}
+	return cvc
+}
+
+// SetData sets the "data" field.
+func (cvc *CharacterVersionCreate) SetData(s string) *CharacterVersionCreate {
+	cvc.mutation.SetData(s)
+	return cvc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cvc *CharacterVersionCreate) SetCreatedAt(t time.Time) *CharacterVersionCreate {
+	cvc.mutation.SetCreatedAt(t)
+	return cvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cvc *CharacterVersionCreate) SetNillableCreatedAt(t *time.Time) *CharacterVersionCreate {
+	if t != nil {
+		cvc.SetCreatedAt(*t)
+	}
+	return cvc
+}
+
+// SetID sets the "id" field.
+func (cvc *CharacterVersionCreate) SetID(u uuid.UUID) *CharacterVersionCreate {
+	cvc.mutation.SetID(u)
+	return cvc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cvc *CharacterVersionCreate) SetNillableID(u *uuid.UUID) *CharacterVersionCreate {
+	if u != nil {
+		cvc.SetID(*u)
+	}
+	return cvc
+}
+
+// SetCharacter sets the "character" edge to the Character entity.
+func (cvc *CharacterVersionCreate) SetCharacter(c *Character) *CharacterVersionCreate {
+	return cvc.SetCharacterID(c.ID)
+}
+
+// Mutation returns the CharacterVersionMutation object of the builder.
+func (cvc *CharacterVersionCreate) Mutation() *CharacterVersionMutation {
+	return cvc.mutation
+}
+
+// Save creates the CharacterVersion in the database.
+func (cvc *CharacterVersionCreate) Save(ctx context.Context) (*CharacterVersion, error) {
+	var (
+		err  error
+		node *CharacterVersion
+	)
+	cvc.defaults()
+	if len(cvc.hooks) == 0 {
+		if err = cvc.check(); err != nil {
+			return nil, err
+		}
+		node, err = cvc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CharacterVersionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cvc.check(); err != nil {
+				return nil, err
+			}
+			cvc.mutation = mutation
+			if node, err = cvc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cvc.hooks) - 1; i >= 0; i-- {
+			if cvc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cvc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cvc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cvc *CharacterVersionCreate) SaveX(ctx context.Context) *CharacterVersion {
+	v, err := cvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cvc *CharacterVersionCreate) Exec(ctx context.Context) error {
+	_, err := cvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvc *CharacterVersionCreate) ExecX(ctx context.Context) {
+	if err := cvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cvc *CharacterVersionCreate) defaults() {
+	if _, ok := cvc.mutation.Size(); !ok {
+		v := characterversion.DefaultSize
+		cvc.mutation.SetSize(v)
+	}
+	if _, ok := cvc.mutation.CreatedAt(); !ok {
+		v := characterversion.DefaultCreatedAt()
+		cvc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cvc.mutation.ID(); !ok {
+		v := characterversion.DefaultID()
+		cvc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cvc *CharacterVersionCreate) check() error {
+	if _, ok := cvc.mutation.CharacterID(); !ok {
+		return &ValidationError{Name: "character_id", err: errors.New(`ent: missing required field "CharacterVersion.character_id"`)}
+	}
+	if _, ok := cvc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "CharacterVersion.version"`)}
+	}
+	if v, ok := cvc.mutation.Version(); ok {
+		if err := characterversion.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "CharacterVersion.version": %w`, err)}
+		}
+	}
+	if _, ok := cvc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "CharacterVersion.size"`)}
+	}
+	if _, ok := cvc.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New(`ent: missing required field "CharacterVersion.data"`)}
+	}
+	if _, ok := cvc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CharacterVersion.created_at"`)}
+	}
+	if _, ok := cvc.mutation.CharacterID(); !ok {
+		return &ValidationError{Name: "character", err: errors.New(`ent: missing required edge "CharacterVersion.character"`)}
+	}
+	return nil
+}
+
+func (cvc *CharacterVersionCreate) sqlSave(ctx context.Context) (*CharacterVersion, error) {
+	_node, _spec := cvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (cvc *CharacterVersionCreate) createSpec() (*CharacterVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CharacterVersion{config: cvc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: characterversion.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: characterversion.FieldID,
+			},
+		}
+	)
+	if id, ok := cvc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cvc.mutation.Version(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: characterversion.FieldVersion,
+		})
+		_node.Version = value
+	}
+	if value, ok := cvc.mutation.Size(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: characterversion.FieldSize,
+		})
+		_node.Size = value
+	}
+	if value, ok := cvc.mutation.Data(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: characterversion.FieldData,
+		})
+		_node.Data = value
+	}
+	if value, ok := cvc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: characterversion.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := cvc.mutation.CharacterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   characterversion.CharacterTable,
+			Columns: []string{characterversion.CharacterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: character.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CharacterID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CharacterVersionCreateBulk is the builder for creating many CharacterVersion entities in bulk.
+type CharacterVersionCreateBulk struct {
+	config
+	builders []*CharacterVersionCreate
+}
+
+// Save creates the CharacterVersion entities in the database.
+func (cvcb *CharacterVersionCreateBulk) Save(ctx context.Context) ([]*CharacterVersion, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cvcb.builders))
+	nodes := make([]*CharacterVersion, len(cvcb.builders))
+	mutators := make([]Mutator, len(cvcb.builders))
+	for i := range cvcb.builders {
+		func(i int, root context.Context) {
+			builder := cvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CharacterVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cvcb *CharacterVersionCreateBulk) SaveX(ctx context.Context) []*CharacterVersion {
+	v, err := cvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cvcb *CharacterVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := cvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvcb *CharacterVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := cvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
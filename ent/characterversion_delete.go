@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/msrevive/nexus2/ent/characterversion"
+	"github.com/msrevive/nexus2/ent/predicate"
+)
+
+// CharacterVersionDelete is the builder for deleting a CharacterVersion entity.
+type CharacterVersionDelete struct {
+	config
+	hooks    []Hook
+	mutation *CharacterVersionMutation
+}
+
+// Where appends a list predicates to the CharacterVersionDelete builder.
+func (cvd *CharacterVersionDelete) Where(ps ...predicate.CharacterVersion) *CharacterVersionDelete {
+	cvd.mutation.Where(ps...)
+	return cvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cvd *CharacterVersionDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cvd.hooks) == 0 {
+		affected, err = cvd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CharacterVersionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			cvd.mutation = mutation
+			affected, err = cvd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cvd.hooks) - 1; i >= 0; i-- {
+			if cvd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cvd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cvd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvd *CharacterVersionDelete) ExecX(ctx context.Context) int {
+	n, err := cvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cvd *CharacterVersionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: characterversion.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: characterversion.FieldID,
+			},
+		},
+	}
+	if ps := cvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, cvd.driver, _spec)
+}
+
+// CharacterVersionDeleteOne is the builder for deleting a single CharacterVersion entity.
+type CharacterVersionDeleteOne struct {
+	cvd *CharacterVersionDelete
+}
+
+// Exec executes the deletion query.
+func (cvdo *CharacterVersionDeleteOne) Exec(ctx context.Context) error {
+	n, err := cvdo.cvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{characterversion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvdo *CharacterVersionDeleteOne) ExecX(ctx context.Context) {
+	cvdo.cvd.ExecX(ctx)
+}
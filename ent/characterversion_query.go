@@ -0,0 +1,989 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/character"
+	"github.com/msrevive/nexus2/ent/characterversion"
+	"github.com/msrevive/nexus2/ent/predicate"
+)
+
+// CharacterVersionQuery is the builder for querying CharacterVersion entities.
+type CharacterVersionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.CharacterVersion
+	// eager-loading edges.
+	withCharacter *CharacterQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CharacterVersionQuery builder.
+func (cvq *CharacterVersionQuery) Where(ps ...predicate.CharacterVersion) *CharacterVersionQuery {
+	cvq.predicates = append(cvq.predicates, ps...)
+	return cvq
+}
+
+// Limit adds a limit step to the query.
+func (cvq *CharacterVersionQuery) Limit(limit int) *CharacterVersionQuery {
+	cvq.limit = &limit
+	return cvq
+}
+
+// Offset adds an offset step to the query.
+func (cvq *CharacterVersionQuery) Offset(offset int) *CharacterVersionQuery {
+	cvq.offset = &offset
+	return cvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cvq *CharacterVersionQuery) Unique(unique bool) *CharacterVersionQuery {
+	cvq.unique = &unique
+	return cvq
+}
+
+// Order adds an order step to the query.
+func (cvq *CharacterVersionQuery) Order(o ...OrderFunc) *CharacterVersionQuery {
+	cvq.order = append(cvq.order, o...)
+	return cvq
+}
+
+// QueryCharacter chains the current query on the "character" edge.
+func (cvq *CharacterVersionQuery) QueryCharacter() *CharacterQuery {
+	query := &CharacterQuery{config: cvq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(characterversion.Table, characterversion.FieldID, selector),
+			sqlgraph.To(character.Table, character.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, characterversion.CharacterTable, characterversion.CharacterColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CharacterVersion entity from the query.
+// Returns a *NotFoundError when no CharacterVersion was found.
+func (cvq *CharacterVersionQuery) First(ctx context.Context) (*CharacterVersion, error) {
+	nodes, err := cvq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{characterversion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cvq *CharacterVersionQuery) FirstX(ctx context.Context) *CharacterVersion {
+	node, err := cvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CharacterVersion ID from the query.
+// Returns a *NotFoundError when no CharacterVersion ID was found.
+func (cvq *CharacterVersionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cvq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{characterversion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cvq *CharacterVersionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := cvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CharacterVersion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CharacterVersion entity is found.
+// Returns a *NotFoundError when no CharacterVersion entities are found.
+func (cvq *CharacterVersionQuery) Only(ctx context.Context) (*CharacterVersion, error) {
+	nodes, err := cvq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{characterversion.Label}
+	default:
+		return nil, &NotSingularError{characterversion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cvq *CharacterVersionQuery) OnlyX(ctx context.Context) *CharacterVersion {
+	node, err := cvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CharacterVersion ID in the query.
+// Returns a *NotSingularError when more than one CharacterVersion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cvq *CharacterVersionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cvq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{characterversion.Label}
+	default:
+		err = &NotSingularError{characterversion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cvq *CharacterVersionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := cvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CharacterVersions.
+func (cvq *CharacterVersionQuery) All(ctx context.Context) ([]*CharacterVersion, error) {
+	if err := cvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return cvq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cvq *CharacterVersionQuery) AllX(ctx context.Context) []*CharacterVersion {
+	nodes, err := cvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CharacterVersion IDs.
+func (cvq *CharacterVersionQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := cvq.Select(characterversion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cvq *CharacterVersionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := cvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cvq *CharacterVersionQuery) Count(ctx context.Context) (int, error) {
+	if err := cvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return cvq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cvq *CharacterVersionQuery) CountX(ctx context.Context) int {
+	count, err := cvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cvq *CharacterVersionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := cvq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return cvq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cvq *CharacterVersionQuery) ExistX(ctx context.Context) bool {
+	exist, err := cvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CharacterVersionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cvq *CharacterVersionQuery) Clone() *CharacterVersionQuery {
+	if cvq == nil {
+		return nil
+	}
+	return &CharacterVersionQuery{
+		config:        cvq.config,
+		limit:         cvq.limit,
+		offset:        cvq.offset,
+		order:         append([]OrderFunc{}, cvq.order...),
+		predicates:    append([]predicate.CharacterVersion{}, cvq.predicates...),
+		withCharacter: cvq.withCharacter.Clone(),
+		// clone intermediate query.
+		sql:  cvq.sql.Clone(),
+		path: cvq.path,
+	}
+}
+
+// WithCharacter tells the query-builder to eager-load the nodes that are connected to
+// the "character" edge. The optional arguments are used to configure the query builder of the edge.
+func (cvq *CharacterVersionQuery) WithCharacter(opts ...func(*CharacterQuery)) *CharacterVersionQuery {
+	query := &CharacterQuery{config: cvq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cvq.withCharacter = query
+	return cvq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CharacterID uuid.UUID `json:"character_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CharacterVersion.Query().
+//		GroupBy(characterversion.FieldCharacterID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (cvq *CharacterVersionQuery) GroupBy(field string, fields ...string) *CharacterVersionGroupBy {
+	group := &CharacterVersionGroupBy{config: cvq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := cvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return cvq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CharacterID uuid.UUID `json:"character_id,omitempty"`
+//	}
+//
+//	client.CharacterVersion.Query().
+//		Select(characterversion.FieldCharacterID).
+//		Scan(ctx, &v)
+//
+func (cvq *CharacterVersionQuery) Select(fields ...string) *CharacterVersionSelect {
+	cvq.fields = append(cvq.fields, fields...)
+	return &CharacterVersionSelect{CharacterVersionQuery: cvq}
+}
+
+func (cvq *CharacterVersionQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range cvq.fields {
+		if !characterversion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cvq.path != nil {
+		prev, err := cvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cvq.sql = prev
+	}
+	return nil
+}
+
+func (cvq *CharacterVersionQuery) sqlAll(ctx context.Context) ([]*CharacterVersion, error) {
+	var (
+		nodes       = []*CharacterVersion{}
+		_spec       = cvq.querySpec()
+		loadedTypes = [1]bool{
+			cvq.withCharacter != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &CharacterVersion{config: cvq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := cvq.withCharacter; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*CharacterVersion)
+		for i := range nodes {
+			fk := nodes[i].CharacterID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(character.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "character_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Character = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (cvq *CharacterVersionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cvq.querySpec()
+	_spec.Node.Columns = cvq.fields
+	if len(cvq.fields) > 0 {
+		_spec.Unique = cvq.unique != nil && *cvq.unique
+	}
+	return sqlgraph.CountNodes(ctx, cvq.driver, _spec)
+}
+
+func (cvq *CharacterVersionQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := cvq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (cvq *CharacterVersionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   characterversion.Table,
+			Columns: characterversion.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: characterversion.FieldID,
+			},
+		},
+		From:   cvq.sql,
+		Unique: true,
+	}
+	if unique := cvq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := cvq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, characterversion.FieldID)
+		for i := range fields {
+			if fields[i] != characterversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cvq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cvq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cvq *CharacterVersionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cvq.driver.Dialect())
+	t1 := builder.Table(characterversion.Table)
+	columns := cvq.fields
+	if len(columns) == 0 {
+		columns = characterversion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cvq.sql != nil {
+		selector = cvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cvq.unique != nil && *cvq.unique {
+		selector.Distinct()
+	}
+	for _, p := range cvq.predicates {
+		p(selector)
+	}
+	for _, p := range cvq.order {
+		p(selector)
+	}
+	if offset := cvq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cvq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CharacterVersionGroupBy is the group-by builder for CharacterVersion entities.
+type CharacterVersionGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cvgb *CharacterVersionGroupBy) Aggregate(fns ...AggregateFunc) *CharacterVersionGroupBy {
+	cvgb.fns = append(cvgb.fns, fns...)
+	return cvgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (cvgb *CharacterVersionGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := cvgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	cvgb.sql = query
+	return cvgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cvgb *CharacterVersionGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := cvgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cvgb *CharacterVersionGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(cvgb.fields) > 1 {
+		return nil, errors.New("ent: CharacterVersionGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := cvgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cvgb *CharacterVersionGroupBy) StringsX(ctx context.Context) []string {
+	v, err := cvgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cvgb *CharacterVersionGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cvgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{characterversion.Label}
+	default:
+		err = fmt.Errorf("ent: CharacterVersionGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cvgb *CharacterVersionGroupBy) StringX(ctx context.Context) string {
+	v, err := cvgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cvgb *CharacterVersionGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(cvgb.fields) > 1 {
+		return nil, errors.New("ent: CharacterVersionGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := cvgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cvgb *CharacterVersionGroupBy) IntsX(ctx context.Context) []int {
+	v, err := cvgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cvgb *CharacterVersionGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cvgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{characterversion.Label}
+	default:
+		err = fmt.Errorf("ent: CharacterVersionGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cvgb *CharacterVersionGroupBy) IntX(ctx context.Context) int {
+	v, err := cvgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cvgb *CharacterVersionGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cvgb.fields) > 1 {
+		return nil, errors.New("ent: CharacterVersionGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := cvgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cvgb *CharacterVersionGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := cvgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cvgb *CharacterVersionGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cvgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{characterversion.Label}
+	default:
+		err = fmt.Errorf("ent: CharacterVersionGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cvgb *CharacterVersionGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := cvgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cvgb *CharacterVersionGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(cvgb.fields) > 1 {
+		return nil, errors.New("ent: CharacterVersionGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := cvgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cvgb *CharacterVersionGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := cvgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cvgb *CharacterVersionGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cvgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{characterversion.Label}
+	default:
+		err = fmt.Errorf("ent: CharacterVersionGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cvgb *CharacterVersionGroupBy) BoolX(ctx context.Context) bool {
+	v, err := cvgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cvgb *CharacterVersionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range cvgb.fields {
+		if !characterversion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := cvgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cvgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (cvgb *CharacterVersionGroupBy) sqlQuery() *sql.Selector {
+	selector := cvgb.sql.Select()
+	aggregation := make([]string, 0, len(cvgb.fns))
+	for _, fn := range cvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(cvgb.fields)+len(cvgb.fns))
+		for _, f := range cvgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(cvgb.fields...)...)
+}
+
+// CharacterVersionSelect is the builder for selecting fields of CharacterVersion entities.
+type CharacterVersionSelect struct {
+	*CharacterVersionQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cvs *CharacterVersionSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := cvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	cvs.sql = cvs.CharacterVersionQuery.sqlQuery(ctx)
+	return cvs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cvs *CharacterVersionSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := cvs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (cvs *CharacterVersionSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(cvs.fields) > 1 {
+		return nil, errors.New("ent: CharacterVersionSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := cvs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cvs *CharacterVersionSelect) StringsX(ctx context.Context) []string {
+	v, err := cvs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (cvs *CharacterVersionSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cvs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{characterversion.Label}
+	default:
+		err = fmt.Errorf("ent: CharacterVersionSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cvs *CharacterVersionSelect) StringX(ctx context.Context) string {
+	v, err := cvs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (cvs *CharacterVersionSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(cvs.fields) > 1 {
+		return nil, errors.New("ent: CharacterVersionSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := cvs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cvs *CharacterVersionSelect) IntsX(ctx context.Context) []int {
+	v, err := cvs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (cvs *CharacterVersionSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cvs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{characterversion.Label}
+	default:
+		err = fmt.Errorf("ent: CharacterVersionSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cvs *CharacterVersionSelect) IntX(ctx context.Context) int {
+	v, err := cvs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (cvs *CharacterVersionSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cvs.fields) > 1 {
+		return nil, errors.New("ent: CharacterVersionSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := cvs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cvs *CharacterVersionSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := cvs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (cvs *CharacterVersionSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cvs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{characterversion.Label}
+	default:
+		err = fmt.Errorf("ent: CharacterVersionSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cvs *CharacterVersionSelect) Float64X(ctx context.Context) float64 {
+	v, err := cvs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (cvs *CharacterVersionSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(cvs.fields) > 1 {
+		return nil, errors.New("ent: CharacterVersionSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := cvs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cvs *CharacterVersionSelect) BoolsX(ctx context.Context) []bool {
+	v, err := cvs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (cvs *CharacterVersionSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cvs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{characterversion.Label}
+	default:
+		err = fmt.Errorf("ent: CharacterVersionSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cvs *CharacterVersionSelect) BoolX(ctx context.Context) bool {
+	v, err := cvs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cvs *CharacterVersionSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := cvs.sql.Query()
+	if err := cvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,373 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/character"
+	"github.com/msrevive/nexus2/ent/characterversion"
+	"github.com/msrevive/nexus2/ent/predicate"
+)
+
+// CharacterVersionUpdate is the builder for updating CharacterVersion entities.
+type CharacterVersionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CharacterVersionMutation
+}
+
+// Where appends a list predicates to the CharacterVersionUpdate builder.
+func (cvu *CharacterVersionUpdate) Where(ps ...predicate.CharacterVersion) *CharacterVersionUpdate {
+	cvu.mutation.Where(ps...)
+	return cvu
+}
+
+// SetCharacterID sets the "character_id" field.
+func (cvu *CharacterVersionUpdate) SetCharacterID(u uuid.UUID) *CharacterVersionUpdate {
+	cvu.mutation.SetCharacterID(u)
+	return cvu
+}
+
+// SetCharacter sets the "character" edge to the Character entity.
+func (cvu *CharacterVersionUpdate) SetCharacter(c *Character) *CharacterVersionUpdate {
+	return cvu.SetCharacterID(c.ID)
+}
+
+// Mutation returns the CharacterVersionMutation object of the builder.
+func (cvu *CharacterVersionUpdate) Mutation() *CharacterVersionMutation {
+	return cvu.mutation
+}
+
+// ClearCharacter clears the "character" edge to the Character entity.
+func (cvu *CharacterVersionUpdate) ClearCharacter() *CharacterVersionUpdate {
+	cvu.mutation.ClearCharacter()
+	return cvu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cvu *CharacterVersionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cvu.hooks) == 0 {
+		if err = cvu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = cvu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CharacterVersionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cvu.check(); err != nil {
+				return 0, err
+			}
+			cvu.mutation = mutation
+			affected, err = cvu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cvu.hooks) - 1; i >= 0; i-- {
+			if cvu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cvu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cvu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cvu *CharacterVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := cvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cvu *CharacterVersionUpdate) Exec(ctx context.Context) error {
+	_, err := cvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvu *CharacterVersionUpdate) ExecX(ctx context.Context) {
+	if err := cvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cvu *CharacterVersionUpdate) check() error {
+	if _, ok := cvu.mutation.CharacterID(); cvu.mutation.CharacterCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CharacterVersion.character"`)
+	}
+	return nil
+}
+
+func (cvu *CharacterVersionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   characterversion.Table,
+			Columns: characterversion.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: characterversion.FieldID,
+			},
+		},
+	}
+	if ps := cvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if cvu.mutation.CharacterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   characterversion.CharacterTable,
+			Columns: []string{characterversion.CharacterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: character.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cvu.mutation.CharacterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   characterversion.CharacterTable,
+			Columns: []string{characterversion.CharacterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: character.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{characterversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// CharacterVersionUpdateOne is the builder for updating a single CharacterVersion entity.
+type CharacterVersionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CharacterVersionMutation
+}
+
+// SetCharacterID sets the "character_id" field.
+func (cvuo *CharacterVersionUpdateOne) SetCharacterID(u uuid.UUID) *CharacterVersionUpdateOne {
+	cvuo.mutation.SetCharacterID(u)
+	return cvuo
+}
+
+// SetCharacter sets the "character" edge to the Character entity.
+func (cvuo *CharacterVersionUpdateOne) SetCharacter(c *Character) *CharacterVersionUpdateOne {
+	return cvuo.SetCharacterID(c.ID)
+}
+
+// Mutation returns the CharacterVersionMutation object of the builder.
+func (cvuo *CharacterVersionUpdateOne) Mutation() *CharacterVersionMutation {
+	return cvuo.mutation
+}
+
+// ClearCharacter clears the "character" edge to the Character entity.
+func (cvuo *CharacterVersionUpdateOne) ClearCharacter() *CharacterVersionUpdateOne {
+	cvuo.mutation.ClearCharacter()
+	return cvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cvuo *CharacterVersionUpdateOne) Select(field string, fields ...string) *CharacterVersionUpdateOne {
+	cvuo.fields = append([]string{field}, fields...)
+	return cvuo
+}
+
+// Save executes the query and returns the updated CharacterVersion entity.
+func (cvuo *CharacterVersionUpdateOne) Save(ctx context.Context) (*CharacterVersion, error) {
+	var (
+		err  error
+		node *CharacterVersion
+	)
+	if len(cvuo.hooks) == 0 {
+		if err = cvuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = cvuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CharacterVersionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cvuo.check(); err != nil {
+				return nil, err
+			}
+			cvuo.mutation = mutation
+			node, err = cvuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cvuo.hooks) - 1; i >= 0; i-- {
+			if cvuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cvuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cvuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cvuo *CharacterVersionUpdateOne) SaveX(ctx context.Context) *CharacterVersion {
+	node, err := cvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cvuo *CharacterVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := cvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvuo *CharacterVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := cvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cvuo *CharacterVersionUpdateOne) check() error {
+	if _, ok := cvuo.mutation.CharacterID(); cvuo.mutation.CharacterCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CharacterVersion.character"`)
+	}
+	return nil
+}
+
+func (cvuo *CharacterVersionUpdateOne) sqlSave(ctx context.Context) (_node *CharacterVersion, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   characterversion.Table,
+			Columns: characterversion.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: characterversion.FieldID,
+			},
+		},
+	}
+	id, ok := cvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CharacterVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, characterversion.FieldID)
+		for _, f := range fields {
+			if !characterversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != characterversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if cvuo.mutation.CharacterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   characterversion.CharacterTable,
+			Columns: []string{characterversion.CharacterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: character.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cvuo.mutation.CharacterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   characterversion.CharacterTable,
+			Columns: []string{characterversion.CharacterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: character.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CharacterVersion{config: cvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{characterversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -10,10 +10,17 @@ import (
 	"github.com/google/uuid"
 	"github.com/msrevive/nexus2/ent/migrate"
 
+	"github.com/msrevive/nexus2/ent/anomaly"
+	"github.com/msrevive/nexus2/ent/auditevent"
+	"github.com/msrevive/nexus2/ent/ban"
 	"github.com/msrevive/nexus2/ent/character"
+	"github.com/msrevive/nexus2/ent/characterlock"
+	"github.com/msrevive/nexus2/ent/characterversion"
+	"github.com/msrevive/nexus2/ent/server"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // Client is the client that holds all ent builders.
@@ -21,8 +28,20 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Anomaly is the client for interacting with the Anomaly builders.
+	Anomaly *AnomalyClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
+	// Ban is the client for interacting with the Ban builders.
+	Ban *BanClient
 	// Character is the client for interacting with the Character builders.
 	Character *CharacterClient
+	// CharacterLock is the client for interacting with the CharacterLock builders.
+	CharacterLock *CharacterLockClient
+	// CharacterVersion is the client for interacting with the CharacterVersion builders.
+	CharacterVersion *CharacterVersionClient
+	// Server is the client for interacting with the Server builders.
+	Server *ServerClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -36,7 +55,13 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Anomaly = NewAnomalyClient(c.config)
+	c.AuditEvent = NewAuditEventClient(c.config)
+	c.Ban = NewBanClient(c.config)
 	c.Character = NewCharacterClient(c.config)
+	c.CharacterLock = NewCharacterLockClient(c.config)
+	c.CharacterVersion = NewCharacterVersionClient(c.config)
+	c.Server = NewServerClient(c.config)
 }
 
 // Open opens a database/sql.DB specified by the driver name and
@@ -68,9 +93,15 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:       ctx,
-		config:    cfg,
-		Character: NewCharacterClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		Anomaly:          NewAnomalyClient(cfg),
+		AuditEvent:       NewAuditEventClient(cfg),
+		Ban:              NewBanClient(cfg),
+		Character:        NewCharacterClient(cfg),
+		CharacterLock:    NewCharacterLockClient(cfg),
+		CharacterVersion: NewCharacterVersionClient(cfg),
+		Server:           NewServerClient(cfg),
 	}, nil
 }
 
@@ -88,16 +119,22 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:       ctx,
-		config:    cfg,
-		Character: NewCharacterClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		Anomaly:          NewAnomalyClient(cfg),
+		AuditEvent:       NewAuditEventClient(cfg),
+		Ban:              NewBanClient(cfg),
+		Character:        NewCharacterClient(cfg),
+		CharacterLock:    NewCharacterLockClient(cfg),
+		CharacterVersion: NewCharacterVersionClient(cfg),
+		Server:           NewServerClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Character.
+//		Anomaly.
 //		Query().
 //		Count(ctx)
 //
@@ -120,7 +157,283 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.Anomaly.Use(hooks...)
+	c.AuditEvent.Use(hooks...)
+	c.Ban.Use(hooks...)
 	c.Character.Use(hooks...)
+	c.CharacterLock.Use(hooks...)
+	c.CharacterVersion.Use(hooks...)
+	c.Server.Use(hooks...)
+}
+
+// AnomalyClient is a client for the Anomaly schema.
+type AnomalyClient struct {
+	config
+}
+
+// NewAnomalyClient returns a client for the Anomaly from the given config.
+func NewAnomalyClient(c config) *AnomalyClient {
+	return &AnomalyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `anomaly.Hooks(f(g(h())))`.
+func (c *AnomalyClient) Use(hooks ...Hook) {
+	c.hooks.Anomaly = append(c.hooks.Anomaly, hooks...)
+}
+
+// Create returns a create builder for Anomaly.
+func (c *AnomalyClient) Create() *AnomalyCreate {
+	mutation := newAnomalyMutation(c.config, OpCreate)
+	return &AnomalyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Anomaly entities.
+func (c *AnomalyClient) CreateBulk(builders ...*AnomalyCreate) *AnomalyCreateBulk {
+	return &AnomalyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Anomaly.
+func (c *AnomalyClient) Update() *AnomalyUpdate {
+	mutation := newAnomalyMutation(c.config, OpUpdate)
+	return &AnomalyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AnomalyClient) UpdateOne(a *Anomaly) *AnomalyUpdateOne {
+	mutation := newAnomalyMutation(c.config, OpUpdateOne, withAnomaly(a))
+	return &AnomalyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AnomalyClient) UpdateOneID(id uuid.UUID) *AnomalyUpdateOne {
+	mutation := newAnomalyMutation(c.config, OpUpdateOne, withAnomalyID(id))
+	return &AnomalyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Anomaly.
+func (c *AnomalyClient) Delete() *AnomalyDelete {
+	mutation := newAnomalyMutation(c.config, OpDelete)
+	return &AnomalyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *AnomalyClient) DeleteOne(a *Anomaly) *AnomalyDeleteOne {
+	return c.DeleteOneID(a.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *AnomalyClient) DeleteOneID(id uuid.UUID) *AnomalyDeleteOne {
+	builder := c.Delete().Where(anomaly.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AnomalyDeleteOne{builder}
+}
+
+// Query returns a query builder for Anomaly.
+func (c *AnomalyClient) Query() *AnomalyQuery {
+	return &AnomalyQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Anomaly entity by its id.
+func (c *AnomalyClient) Get(ctx context.Context, id uuid.UUID) (*Anomaly, error) {
+	return c.Query().Where(anomaly.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AnomalyClient) GetX(ctx context.Context, id uuid.UUID) *Anomaly {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AnomalyClient) Hooks() []Hook {
+	return c.hooks.Anomaly
+}
+
+// AuditEventClient is a client for the AuditEvent schema.
+type AuditEventClient struct {
+	config
+}
+
+// NewAuditEventClient returns a client for the AuditEvent from the given config.
+func NewAuditEventClient(c config) *AuditEventClient {
+	return &AuditEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditevent.Hooks(f(g(h())))`.
+func (c *AuditEventClient) Use(hooks ...Hook) {
+	c.hooks.AuditEvent = append(c.hooks.AuditEvent, hooks...)
+}
+
+// Create returns a create builder for AuditEvent.
+func (c *AuditEventClient) Create() *AuditEventCreate {
+	mutation := newAuditEventMutation(c.config, OpCreate)
+	return &AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditEvent entities.
+func (c *AuditEventClient) CreateBulk(builders ...*AuditEventCreate) *AuditEventCreateBulk {
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditEvent.
+func (c *AuditEventClient) Update() *AuditEventUpdate {
+	mutation := newAuditEventMutation(c.config, OpUpdate)
+	return &AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditEventClient) UpdateOne(ae *AuditEvent) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEvent(ae))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditEventClient) UpdateOneID(id uuid.UUID) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEventID(id))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditEvent.
+func (c *AuditEventClient) Delete() *AuditEventDelete {
+	mutation := newAuditEventMutation(c.config, OpDelete)
+	return &AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *AuditEventClient) DeleteOne(ae *AuditEvent) *AuditEventDeleteOne {
+	return c.DeleteOneID(ae.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *AuditEventClient) DeleteOneID(id uuid.UUID) *AuditEventDeleteOne {
+	builder := c.Delete().Where(auditevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditEventDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditEvent.
+func (c *AuditEventClient) Query() *AuditEventQuery {
+	return &AuditEventQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a AuditEvent entity by its id.
+func (c *AuditEventClient) Get(ctx context.Context, id uuid.UUID) (*AuditEvent, error) {
+	return c.Query().Where(auditevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditEventClient) GetX(ctx context.Context, id uuid.UUID) *AuditEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditEventClient) Hooks() []Hook {
+	return c.hooks.AuditEvent
+}
+
+// BanClient is a client for the Ban schema.
+type BanClient struct {
+	config
+}
+
+// NewBanClient returns a client for the Ban from the given config.
+func NewBanClient(c config) *BanClient {
+	return &BanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ban.Hooks(f(g(h())))`.
+func (c *BanClient) Use(hooks ...Hook) {
+	c.hooks.Ban = append(c.hooks.Ban, hooks...)
+}
+
+// Create returns a create builder for Ban.
+func (c *BanClient) Create() *BanCreate {
+	mutation := newBanMutation(c.config, OpCreate)
+	return &BanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Ban entities.
+func (c *BanClient) CreateBulk(builders ...*BanCreate) *BanCreateBulk {
+	return &BanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Ban.
+func (c *BanClient) Update() *BanUpdate {
+	mutation := newBanMutation(c.config, OpUpdate)
+	return &BanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BanClient) UpdateOne(b *Ban) *BanUpdateOne {
+	mutation := newBanMutation(c.config, OpUpdateOne, withBan(b))
+	return &BanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BanClient) UpdateOneID(id uuid.UUID) *BanUpdateOne {
+	mutation := newBanMutation(c.config, OpUpdateOne, withBanID(id))
+	return &BanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Ban.
+func (c *BanClient) Delete() *BanDelete {
+	mutation := newBanMutation(c.config, OpDelete)
+	return &BanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *BanClient) DeleteOne(b *Ban) *BanDeleteOne {
+	return c.DeleteOneID(b.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *BanClient) DeleteOneID(id uuid.UUID) *BanDeleteOne {
+	builder := c.Delete().Where(ban.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BanDeleteOne{builder}
+}
+
+// Query returns a query builder for Ban.
+func (c *BanClient) Query() *BanQuery {
+	return &BanQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Ban entity by its id.
+func (c *BanClient) Get(ctx context.Context, id uuid.UUID) (*Ban, error) {
+	return c.Query().Where(ban.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BanClient) GetX(ctx context.Context, id uuid.UUID) *Ban {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BanClient) Hooks() []Hook {
+	return c.hooks.Ban
 }
 
 // CharacterClient is a client for the Character schema.
@@ -208,7 +521,309 @@ func (c *CharacterClient) GetX(ctx context.Context, id uuid.UUID) *Character {
 	return obj
 }
 
+// QueryVersions queries the versions edge of a Character.
+func (c *CharacterClient) QueryVersions(ch *Character) *CharacterVersionQuery {
+	query := &CharacterVersionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ch.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(character.Table, character.FieldID, id),
+			sqlgraph.To(characterversion.Table, characterversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, character.VersionsTable, character.VersionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ch.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CharacterClient) Hooks() []Hook {
 	return c.hooks.Character
 }
+
+// CharacterLockClient is a client for the CharacterLock schema.
+type CharacterLockClient struct {
+	config
+}
+
+// NewCharacterLockClient returns a client for the CharacterLock from the given config.
+func NewCharacterLockClient(c config) *CharacterLockClient {
+	return &CharacterLockClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `characterlock.Hooks(f(g(h())))`.
+func (c *CharacterLockClient) Use(hooks ...Hook) {
+	c.hooks.CharacterLock = append(c.hooks.CharacterLock, hooks...)
+}
+
+// Create returns a create builder for CharacterLock.
+func (c *CharacterLockClient) Create() *CharacterLockCreate {
+	mutation := newCharacterLockMutation(c.config, OpCreate)
+	return &CharacterLockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CharacterLock entities.
+func (c *CharacterLockClient) CreateBulk(builders ...*CharacterLockCreate) *CharacterLockCreateBulk {
+	return &CharacterLockCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CharacterLock.
+func (c *CharacterLockClient) Update() *CharacterLockUpdate {
+	mutation := newCharacterLockMutation(c.config, OpUpdate)
+	return &CharacterLockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CharacterLockClient) UpdateOne(cl *CharacterLock) *CharacterLockUpdateOne {
+	mutation := newCharacterLockMutation(c.config, OpUpdateOne, withCharacterLock(cl))
+	return &CharacterLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CharacterLockClient) UpdateOneID(id uuid.UUID) *CharacterLockUpdateOne {
+	mutation := newCharacterLockMutation(c.config, OpUpdateOne, withCharacterLockID(id))
+	return &CharacterLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CharacterLock.
+func (c *CharacterLockClient) Delete() *CharacterLockDelete {
+	mutation := newCharacterLockMutation(c.config, OpDelete)
+	return &CharacterLockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *CharacterLockClient) DeleteOne(cl *CharacterLock) *CharacterLockDeleteOne {
+	return c.DeleteOneID(cl.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *CharacterLockClient) DeleteOneID(id uuid.UUID) *CharacterLockDeleteOne {
+	builder := c.Delete().Where(characterlock.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CharacterLockDeleteOne{builder}
+}
+
+// Query returns a query builder for CharacterLock.
+func (c *CharacterLockClient) Query() *CharacterLockQuery {
+	return &CharacterLockQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a CharacterLock entity by its id.
+func (c *CharacterLockClient) Get(ctx context.Context, id uuid.UUID) (*CharacterLock, error) {
+	return c.Query().Where(characterlock.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CharacterLockClient) GetX(ctx context.Context, id uuid.UUID) *CharacterLock {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CharacterLockClient) Hooks() []Hook {
+	return c.hooks.CharacterLock
+}
+
+// CharacterVersionClient is a client for the CharacterVersion schema.
+type CharacterVersionClient struct {
+	config
+}
+
+// NewCharacterVersionClient returns a client for the CharacterVersion from the given config.
+func NewCharacterVersionClient(c config) *CharacterVersionClient {
+	return &CharacterVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `characterversion.Hooks(f(g(h())))`.
+func (c *CharacterVersionClient) Use(hooks ...Hook) {
+	c.hooks.CharacterVersion = append(c.hooks.CharacterVersion, hooks...)
+}
+
+// Create returns a create builder for CharacterVersion.
+func (c *CharacterVersionClient) Create() *CharacterVersionCreate {
+	mutation := newCharacterVersionMutation(c.config, OpCreate)
+	return &CharacterVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CharacterVersion entities.
+func (c *CharacterVersionClient) CreateBulk(builders ...*CharacterVersionCreate) *CharacterVersionCreateBulk {
+	return &CharacterVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CharacterVersion.
+func (c *CharacterVersionClient) Update() *CharacterVersionUpdate {
+	mutation := newCharacterVersionMutation(c.config, OpUpdate)
+	return &CharacterVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CharacterVersionClient) UpdateOne(cv *CharacterVersion) *CharacterVersionUpdateOne {
+	mutation := newCharacterVersionMutation(c.config, OpUpdateOne, withCharacterVersion(cv))
+	return &CharacterVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CharacterVersionClient) UpdateOneID(id uuid.UUID) *CharacterVersionUpdateOne {
+	mutation := newCharacterVersionMutation(c.config, OpUpdateOne, withCharacterVersionID(id))
+	return &CharacterVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CharacterVersion.
+func (c *CharacterVersionClient) Delete() *CharacterVersionDelete {
+	mutation := newCharacterVersionMutation(c.config, OpDelete)
+	return &CharacterVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *CharacterVersionClient) DeleteOne(cv *CharacterVersion) *CharacterVersionDeleteOne {
+	return c.DeleteOneID(cv.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *CharacterVersionClient) DeleteOneID(id uuid.UUID) *CharacterVersionDeleteOne {
+	builder := c.Delete().Where(characterversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CharacterVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for CharacterVersion.
+func (c *CharacterVersionClient) Query() *CharacterVersionQuery {
+	return &CharacterVersionQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a CharacterVersion entity by its id.
+func (c *CharacterVersionClient) Get(ctx context.Context, id uuid.UUID) (*CharacterVersion, error) {
+	return c.Query().Where(characterversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CharacterVersionClient) GetX(ctx context.Context, id uuid.UUID) *CharacterVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCharacter queries the character edge of a CharacterVersion.
+func (c *CharacterVersionClient) QueryCharacter(cv *CharacterVersion) *CharacterQuery {
+	query := &CharacterQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(characterversion.Table, characterversion.FieldID, id),
+			sqlgraph.To(character.Table, character.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, characterversion.CharacterTable, characterversion.CharacterColumn),
+		)
+		fromV = sqlgraph.Neighbors(cv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CharacterVersionClient) Hooks() []Hook {
+	return c.hooks.CharacterVersion
+}
+
+// ServerClient is a client for the Server schema.
+type ServerClient struct {
+	config
+}
+
+// NewServerClient returns a client for the Server from the given config.
+func NewServerClient(c config) *ServerClient {
+	return &ServerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `server.Hooks(f(g(h())))`.
+func (c *ServerClient) Use(hooks ...Hook) {
+	c.hooks.Server = append(c.hooks.Server, hooks...)
+}
+
+// Create returns a create builder for Server.
+func (c *ServerClient) Create() *ServerCreate {
+	mutation := newServerMutation(c.config, OpCreate)
+	return &ServerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Server entities.
+func (c *ServerClient) CreateBulk(builders ...*ServerCreate) *ServerCreateBulk {
+	return &ServerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Server.
+func (c *ServerClient) Update() *ServerUpdate {
+	mutation := newServerMutation(c.config, OpUpdate)
+	return &ServerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ServerClient) UpdateOne(s *Server) *ServerUpdateOne {
+	mutation := newServerMutation(c.config, OpUpdateOne, withServer(s))
+	return &ServerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ServerClient) UpdateOneID(id uuid.UUID) *ServerUpdateOne {
+	mutation := newServerMutation(c.config, OpUpdateOne, withServerID(id))
+	return &ServerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Server.
+func (c *ServerClient) Delete() *ServerDelete {
+	mutation := newServerMutation(c.config, OpDelete)
+	return &ServerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ServerClient) DeleteOne(s *Server) *ServerDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ServerClient) DeleteOneID(id uuid.UUID) *ServerDeleteOne {
+	builder := c.Delete().Where(server.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ServerDeleteOne{builder}
+}
+
+// Query returns a query builder for Server.
+func (c *ServerClient) Query() *ServerQuery {
+	return &ServerQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Server entity by its id.
+func (c *ServerClient) Get(ctx context.Context, id uuid.UUID) (*Server, error) {
+	return c.Query().Where(server.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ServerClient) GetX(ctx context.Context, id uuid.UUID) *Server {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ServerClient) Hooks() []Hook {
+	return c.hooks.Server
+}
@@ -24,7 +24,13 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Character []ent.Hook
+	Anomaly          []ent.Hook
+	AuditEvent       []ent.Hook
+	Ban              []ent.Hook
+	Character        []ent.Hook
+	CharacterLock    []ent.Hook
+	CharacterVersion []ent.Hook
+	Server           []ent.Hook
 }
 
 // Options applies the options on the config object.
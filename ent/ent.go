@@ -8,7 +8,13 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/msrevive/nexus2/ent/anomaly"
+	"github.com/msrevive/nexus2/ent/auditevent"
+	"github.com/msrevive/nexus2/ent/ban"
 	"github.com/msrevive/nexus2/ent/character"
+	"github.com/msrevive/nexus2/ent/characterlock"
+	"github.com/msrevive/nexus2/ent/characterversion"
+	"github.com/msrevive/nexus2/ent/server"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -29,7 +35,13 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		character.Table: character.ValidColumn,
+		anomaly.Table:          anomaly.ValidColumn,
+		auditevent.Table:       auditevent.ValidColumn,
+		ban.Table:              ban.ValidColumn,
+		character.Table:        character.ValidColumn,
+		characterlock.Table:    characterlock.ValidColumn,
+		characterversion.Table: characterversion.ValidColumn,
+		server.Table:           server.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
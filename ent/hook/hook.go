@@ -9,6 +9,45 @@ import (
 	"github.com/msrevive/nexus2/ent"
 )
 
+// The AnomalyFunc type is an adapter to allow the use of ordinary
+// function as Anomaly mutator.
+type AnomalyFunc func(context.Context, *ent.AnomalyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AnomalyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.AnomalyMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AnomalyMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The AuditEventFunc type is an adapter to allow the use of ordinary
+// function as AuditEvent mutator.
+type AuditEventFunc func(context.Context, *ent.AuditEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.AuditEventMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditEventMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The BanFunc type is an adapter to allow the use of ordinary
+// function as Ban mutator.
+type BanFunc func(context.Context, *ent.BanMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BanFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.BanMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BanMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The CharacterFunc type is an adapter to allow the use of ordinary
 // function as Character mutator.
 type CharacterFunc func(context.Context, *ent.CharacterMutation) (ent.Value, error)
@@ -22,6 +61,45 @@ func (f CharacterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return f(ctx, mv)
 }
 
+// The CharacterLockFunc type is an adapter to allow the use of ordinary
+// function as CharacterLock mutator.
+type CharacterLockFunc func(context.Context, *ent.CharacterLockMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CharacterLockFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.CharacterLockMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CharacterLockMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The CharacterVersionFunc type is an adapter to allow the use of ordinary
+// function as CharacterVersion mutator.
+type CharacterVersionFunc func(context.Context, *ent.CharacterVersionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CharacterVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.CharacterVersionMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CharacterVersionMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ServerFunc type is an adapter to allow the use of ordinary
+// function as Server mutator.
+type ServerFunc func(context.Context, *ent.ServerMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ServerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ServerMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ServerMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -8,13 +8,110 @@ import (
 )
 
 var (
+	// AnomaliesColumns holds the columns for the "anomalies" table.
+	AnomaliesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "character_id", Type: field.TypeUUID},
+		{Name: "steamid", Type: field.TypeString},
+		{Name: "server", Type: field.TypeString, Default: ""},
+		{Name: "action", Type: field.TypeString},
+		{Name: "findings", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeString, Default: "open"},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "size", Type: field.TypeInt, Default: 0},
+		{Name: "data", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "longtext", "postgres": "text", "sqlite3": "text"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reviewed_by", Type: field.TypeString, Default: ""},
+	}
+	// AnomaliesTable holds the schema information for the "anomalies" table.
+	AnomaliesTable = &schema.Table{
+		Name:       "anomalies",
+		Columns:    AnomaliesColumns,
+		PrimaryKey: []*schema.Column{AnomaliesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "anomaly_status",
+				Unique:  false,
+				Columns: []*schema.Column{AnomaliesColumns[6]},
+			},
+			{
+				Name:    "anomaly_steamid",
+				Unique:  false,
+				Columns: []*schema.Column{AnomaliesColumns[2]},
+			},
+		},
+	}
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "actor", Type: field.TypeString, Default: ""},
+		{Name: "server", Type: field.TypeString, Default: ""},
+		{Name: "action", Type: field.TypeString},
+		{Name: "steamid", Type: field.TypeString, Default: ""},
+		{Name: "character_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "size_before", Type: field.TypeInt, Nullable: true},
+		{Name: "size_after", Type: field.TypeInt, Nullable: true},
+		{Name: "request_id", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_steamid",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[4]},
+			},
+			{
+				Name:    "auditevent_actor",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1]},
+			},
+			{
+				Name:    "auditevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[9]},
+			},
+		},
+	}
+	// BansColumns holds the columns for the "bans" table.
+	BansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "steamid", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Default: ""},
+		{Name: "issued_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+	}
+	// BansTable holds the schema information for the "bans" table.
+	BansTable = &schema.Table{
+		Name:       "bans",
+		Columns:    BansColumns,
+		PrimaryKey: []*schema.Column{BansColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ban_steamid",
+				Unique:  false,
+				Columns: []*schema.Column{BansColumns[1]},
+			},
+		},
+	}
 	// CharactersColumns holds the columns for the "characters" table.
 	CharactersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "steamid", Type: field.TypeString},
 		{Name: "slot", Type: field.TypeInt, Default: 0},
 		{Name: "size", Type: field.TypeInt, Default: 0},
-		{Name: "data", Type: field.TypeString, SchemaType: map[string]string{"sqlite3": "text"}},
+		{Name: "data", Type: field.TypeString, SchemaType: map[string]string{"mysql": "longtext", "postgres": "text", "sqlite3": "text"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "live", Type: field.TypeBool, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Nullable: true},
 	}
 	// CharactersTable holds the schema information for the "characters" table.
 	CharactersTable = &schema.Table{
@@ -28,17 +125,100 @@ var (
 				Columns: []*schema.Column{CharactersColumns[0]},
 			},
 			{
-				Name:    "character_steamid_slot",
-				Unique:  false,
-				Columns: []*schema.Column{CharactersColumns[1], CharactersColumns[2]},
+				Name:    "character_live_slot",
+				Unique:  true,
+				Columns: []*schema.Column{CharactersColumns[1], CharactersColumns[2], CharactersColumns[6]},
+			},
+		},
+	}
+	// CharacterLocksColumns holds the columns for the "character_locks" table.
+	CharacterLocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "character_id", Type: field.TypeUUID},
+		{Name: "server", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// CharacterLocksTable holds the schema information for the "character_locks" table.
+	CharacterLocksTable = &schema.Table{
+		Name:       "character_locks",
+		Columns:    CharacterLocksColumns,
+		PrimaryKey: []*schema.Column{CharacterLocksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "characterlock_character_id",
+				Unique:  true,
+				Columns: []*schema.Column{CharacterLocksColumns[1]},
+			},
+		},
+	}
+	// CharacterVersionsColumns holds the columns for the "character_versions" table.
+	CharacterVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "size", Type: field.TypeInt, Default: 0},
+		{Name: "data", Type: field.TypeString, SchemaType: map[string]string{"mysql": "longtext", "postgres": "text", "sqlite3": "text"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "character_id", Type: field.TypeUUID},
+	}
+	// CharacterVersionsTable holds the schema information for the "character_versions" table.
+	CharacterVersionsTable = &schema.Table{
+		Name:       "character_versions",
+		Columns:    CharacterVersionsColumns,
+		PrimaryKey: []*schema.Column{CharacterVersionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "character_versions_characters_versions",
+				Columns:    []*schema.Column{CharacterVersionsColumns[5]},
+				RefColumns: []*schema.Column{CharactersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "characterversion_character_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{CharacterVersionsColumns[5], CharacterVersionsColumns[1]},
+			},
+		},
+	}
+	// ServersColumns holds the columns for the "servers" table.
+	ServersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "address", Type: field.TypeString, Default: ""},
+		{Name: "map", Type: field.TypeString, Default: ""},
+		{Name: "players", Type: field.TypeInt, Default: 0},
+		{Name: "max_players", Type: field.TypeInt, Default: 0},
+		{Name: "online", Type: field.TypeBool, Default: true},
+		{Name: "last_heartbeat", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ServersTable holds the schema information for the "servers" table.
+	ServersTable = &schema.Table{
+		Name:       "servers",
+		Columns:    ServersColumns,
+		PrimaryKey: []*schema.Column{ServersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "server_name",
+				Unique:  true,
+				Columns: []*schema.Column{ServersColumns[1]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AnomaliesTable,
+		AuditEventsTable,
+		BansTable,
 		CharactersTable,
+		CharacterLocksTable,
+		CharacterVersionsTable,
+		ServersTable,
 	}
 )
 
 func init() {
+	CharacterVersionsTable.ForeignKeys[0].RefTable = CharactersTable
 }
@@ -7,10 +7,17 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/anomaly"
+	"github.com/msrevive/nexus2/ent/auditevent"
+	"github.com/msrevive/nexus2/ent/ban"
 	"github.com/msrevive/nexus2/ent/character"
+	"github.com/msrevive/nexus2/ent/characterlock"
+	"github.com/msrevive/nexus2/ent/characterversion"
 	"github.com/msrevive/nexus2/ent/predicate"
+	"github.com/msrevive/nexus2/ent/server"
 
 	"entgo.io/ent"
 )
@@ -24,38 +31,52 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeCharacter = "Character"
+	TypeAnomaly          = "Anomaly"
+	TypeAuditEvent       = "AuditEvent"
+	TypeBan              = "Ban"
+	TypeCharacter        = "Character"
+	TypeCharacterLock    = "CharacterLock"
+	TypeCharacterVersion = "CharacterVersion"
+	TypeServer           = "Server"
 )
 
-// CharacterMutation represents an operation that mutates the Character nodes in the graph.
-type CharacterMutation struct {
+// AnomalyMutation represents an operation that mutates the Anomaly nodes in the graph.
+type AnomalyMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
+	character_id  *uuid.UUID
 	steamid       *string
-	slot          *int
-	addslot       *int
+	server        *string
+	action        *string
+	findings      *[]string
+	status        *string
+	version       *int
+	addversion    *int
 	size          *int
 	addsize       *int
 	data          *string
+	created_at    *time.Time
+	reviewed_at   *time.Time
+	reviewed_by   *string
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Character, error)
-	predicates    []predicate.Character
+	oldValue      func(context.Context) (*Anomaly, error)
+	predicates    []predicate.Anomaly
 }
 
-var _ ent.Mutation = (*CharacterMutation)(nil)
+var _ ent.Mutation = (*AnomalyMutation)(nil)
 
-// characterOption allows management of the mutation configuration using functional options.
-type characterOption func(*CharacterMutation)
+// anomalyOption allows management of the mutation configuration using functional options.
+type anomalyOption func(*AnomalyMutation)
 
-// newCharacterMutation creates new mutation for the Character entity.
-func newCharacterMutation(c config, op Op, opts ...characterOption) *CharacterMutation {
-	m := &CharacterMutation{
+// newAnomalyMutation creates new mutation for the Anomaly entity.
+func newAnomalyMutation(c config, op Op, opts ...anomalyOption) *AnomalyMutation {
+	m := &AnomalyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCharacter,
+		typ:           TypeAnomaly,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -64,20 +85,20 @@ func newCharacterMutation(c config, op Op, opts ...characterOption) *CharacterMu
 	return m
 }
 
-// withCharacterID sets the ID field of the mutation.
-func withCharacterID(id uuid.UUID) characterOption {
-	return func(m *CharacterMutation) {
+// withAnomalyID sets the ID field of the mutation.
+func withAnomalyID(id uuid.UUID) anomalyOption {
+	return func(m *AnomalyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Character
+			value *Anomaly
 		)
-		m.oldValue = func(ctx context.Context) (*Character, error) {
+		m.oldValue = func(ctx context.Context) (*Anomaly, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Character.Get(ctx, id)
+					value, err = m.Client().Anomaly.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -86,10 +107,10 @@ func withCharacterID(id uuid.UUID) characterOption {
 	}
 }
 
-// withCharacter sets the old Character of the mutation.
-func withCharacter(node *Character) characterOption {
-	return func(m *CharacterMutation) {
-		m.oldValue = func(context.Context) (*Character, error) {
+// withAnomaly sets the old Anomaly of the mutation.
+func withAnomaly(node *Anomaly) anomalyOption {
+	return func(m *AnomalyMutation) {
+		m.oldValue = func(context.Context) (*Anomaly, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -98,7 +119,7 @@ func withCharacter(node *Character) characterOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CharacterMutation) Client() *Client {
+func (m AnomalyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -106,7 +127,7 @@ func (m CharacterMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CharacterMutation) Tx() (*Tx, error) {
+func (m AnomalyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -115,255 +136,5042 @@ func (m CharacterMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Character entities.
-func (m *CharacterMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Anomaly entities.
+func (m *AnomalyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AnomalyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AnomalyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Anomaly.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCharacterID sets the "character_id" field.
+func (m *AnomalyMutation) SetCharacterID(u uuid.UUID) {
+	m.character_id = &u
+}
+
+// CharacterID returns the value of the "character_id" field in the mutation.
+func (m *AnomalyMutation) CharacterID() (r uuid.UUID, exists bool) {
+	v := m.character_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCharacterID returns the old "character_id" field's value of the Anomaly entity.
+// If the Anomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnomalyMutation) OldCharacterID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCharacterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCharacterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCharacterID: %w", err)
+	}
+	return oldValue.CharacterID, nil
+}
+
+// ResetCharacterID resets all changes to the "character_id" field.
+func (m *AnomalyMutation) ResetCharacterID() {
+	m.character_id = nil
+}
+
+// SetSteamid sets the "steamid" field.
+func (m *AnomalyMutation) SetSteamid(s string) {
+	m.steamid = &s
+}
+
+// Steamid returns the value of the "steamid" field in the mutation.
+func (m *AnomalyMutation) Steamid() (r string, exists bool) {
+	v := m.steamid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSteamid returns the old "steamid" field's value of the Anomaly entity.
+// If the Anomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnomalyMutation) OldSteamid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSteamid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSteamid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSteamid: %w", err)
+	}
+	return oldValue.Steamid, nil
+}
+
+// ResetSteamid resets all changes to the "steamid" field.
+func (m *AnomalyMutation) ResetSteamid() {
+	m.steamid = nil
+}
+
+// SetServer sets the "server" field.
+func (m *AnomalyMutation) SetServer(s string) {
+	m.server = &s
+}
+
+// Server returns the value of the "server" field in the mutation.
+func (m *AnomalyMutation) Server() (r string, exists bool) {
+	v := m.server
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServer returns the old "server" field's value of the Anomaly entity.
+// If the Anomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnomalyMutation) OldServer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServer: %w", err)
+	}
+	return oldValue.Server, nil
+}
+
+// ResetServer resets all changes to the "server" field.
+func (m *AnomalyMutation) ResetServer() {
+	m.server = nil
+}
+
+// SetAction sets the "action" field.
+func (m *AnomalyMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *AnomalyMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the Anomaly entity.
+// If the Anomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnomalyMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *AnomalyMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetFindings sets the "findings" field.
+func (m *AnomalyMutation) SetFindings(s []string) {
+	m.findings = &s
+}
+
+// Findings returns the value of the "findings" field in the mutation.
+func (m *AnomalyMutation) Findings() (r []string, exists bool) {
+	v := m.findings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFindings returns the old "findings" field's value of the Anomaly entity.
+// If the Anomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnomalyMutation) OldFindings(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFindings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFindings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFindings: %w", err)
+	}
+	return oldValue.Findings, nil
+}
+
+// ResetFindings resets all changes to the "findings" field.
+func (m *AnomalyMutation) ResetFindings() {
+	m.findings = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *AnomalyMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *AnomalyMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Anomaly entity.
+// If the Anomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnomalyMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *AnomalyMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *AnomalyMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *AnomalyMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Anomaly entity.
+// If the Anomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnomalyMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *AnomalyMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *AnomalyMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *AnomalyMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetSize sets the "size" field.
+func (m *AnomalyMutation) SetSize(i int) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *AnomalyMutation) Size() (r int, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the Anomaly entity.
+// If the Anomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnomalyMutation) OldSize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *AnomalyMutation) AddSize(i int) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *AnomalyMutation) AddedSize() (r int, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *AnomalyMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetData sets the "data" field.
+func (m *AnomalyMutation) SetData(s string) {
+	m.data = &s
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *AnomalyMutation) Data() (r string, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the Anomaly entity.
+// If the Anomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnomalyMutation) OldData(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ClearData clears the value of the "data" field.
+func (m *AnomalyMutation) ClearData() {
+	m.data = nil
+	m.clearedFields[anomaly.FieldData] = struct{}{}
+}
+
+// DataCleared returns if the "data" field was cleared in this mutation.
+func (m *AnomalyMutation) DataCleared() bool {
+	_, ok := m.clearedFields[anomaly.FieldData]
+	return ok
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *AnomalyMutation) ResetData() {
+	m.data = nil
+	delete(m.clearedFields, anomaly.FieldData)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AnomalyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AnomalyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Anomaly entity.
+// If the Anomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnomalyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AnomalyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (m *AnomalyMutation) SetReviewedAt(t time.Time) {
+	m.reviewed_at = &t
+}
+
+// ReviewedAt returns the value of the "reviewed_at" field in the mutation.
+func (m *AnomalyMutation) ReviewedAt() (r time.Time, exists bool) {
+	v := m.reviewed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewedAt returns the old "reviewed_at" field's value of the Anomaly entity.
+// If the Anomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnomalyMutation) OldReviewedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewedAt: %w", err)
+	}
+	return oldValue.ReviewedAt, nil
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (m *AnomalyMutation) ClearReviewedAt() {
+	m.reviewed_at = nil
+	m.clearedFields[anomaly.FieldReviewedAt] = struct{}{}
+}
+
+// ReviewedAtCleared returns if the "reviewed_at" field was cleared in this mutation.
+func (m *AnomalyMutation) ReviewedAtCleared() bool {
+	_, ok := m.clearedFields[anomaly.FieldReviewedAt]
+	return ok
+}
+
+// ResetReviewedAt resets all changes to the "reviewed_at" field.
+func (m *AnomalyMutation) ResetReviewedAt() {
+	m.reviewed_at = nil
+	delete(m.clearedFields, anomaly.FieldReviewedAt)
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (m *AnomalyMutation) SetReviewedBy(s string) {
+	m.reviewed_by = &s
+}
+
+// ReviewedBy returns the value of the "reviewed_by" field in the mutation.
+func (m *AnomalyMutation) ReviewedBy() (r string, exists bool) {
+	v := m.reviewed_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewedBy returns the old "reviewed_by" field's value of the Anomaly entity.
+// If the Anomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnomalyMutation) OldReviewedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewedBy: %w", err)
+	}
+	return oldValue.ReviewedBy, nil
+}
+
+// ResetReviewedBy resets all changes to the "reviewed_by" field.
+func (m *AnomalyMutation) ResetReviewedBy() {
+	m.reviewed_by = nil
+}
+
+// Where appends a list predicates to the AnomalyMutation builder.
+func (m *AnomalyMutation) Where(ps ...predicate.Anomaly) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *AnomalyMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Anomaly).
+func (m *AnomalyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AnomalyMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.character_id != nil {
+		fields = append(fields, anomaly.FieldCharacterID)
+	}
+	if m.steamid != nil {
+		fields = append(fields, anomaly.FieldSteamid)
+	}
+	if m.server != nil {
+		fields = append(fields, anomaly.FieldServer)
+	}
+	if m.action != nil {
+		fields = append(fields, anomaly.FieldAction)
+	}
+	if m.findings != nil {
+		fields = append(fields, anomaly.FieldFindings)
+	}
+	if m.status != nil {
+		fields = append(fields, anomaly.FieldStatus)
+	}
+	if m.version != nil {
+		fields = append(fields, anomaly.FieldVersion)
+	}
+	if m.size != nil {
+		fields = append(fields, anomaly.FieldSize)
+	}
+	if m.data != nil {
+		fields = append(fields, anomaly.FieldData)
+	}
+	if m.created_at != nil {
+		fields = append(fields, anomaly.FieldCreatedAt)
+	}
+	if m.reviewed_at != nil {
+		fields = append(fields, anomaly.FieldReviewedAt)
+	}
+	if m.reviewed_by != nil {
+		fields = append(fields, anomaly.FieldReviewedBy)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AnomalyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case anomaly.FieldCharacterID:
+		return m.CharacterID()
+	case anomaly.FieldSteamid:
+		return m.Steamid()
+	case anomaly.FieldServer:
+		return m.Server()
+	case anomaly.FieldAction:
+		return m.Action()
+	case anomaly.FieldFindings:
+		return m.Findings()
+	case anomaly.FieldStatus:
+		return m.Status()
+	case anomaly.FieldVersion:
+		return m.Version()
+	case anomaly.FieldSize:
+		return m.Size()
+	case anomaly.FieldData:
+		return m.Data()
+	case anomaly.FieldCreatedAt:
+		return m.CreatedAt()
+	case anomaly.FieldReviewedAt:
+		return m.ReviewedAt()
+	case anomaly.FieldReviewedBy:
+		return m.ReviewedBy()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AnomalyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case anomaly.FieldCharacterID:
+		return m.OldCharacterID(ctx)
+	case anomaly.FieldSteamid:
+		return m.OldSteamid(ctx)
+	case anomaly.FieldServer:
+		return m.OldServer(ctx)
+	case anomaly.FieldAction:
+		return m.OldAction(ctx)
+	case anomaly.FieldFindings:
+		return m.OldFindings(ctx)
+	case anomaly.FieldStatus:
+		return m.OldStatus(ctx)
+	case anomaly.FieldVersion:
+		return m.OldVersion(ctx)
+	case anomaly.FieldSize:
+		return m.OldSize(ctx)
+	case anomaly.FieldData:
+		return m.OldData(ctx)
+	case anomaly.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case anomaly.FieldReviewedAt:
+		return m.OldReviewedAt(ctx)
+	case anomaly.FieldReviewedBy:
+		return m.OldReviewedBy(ctx)
+	}
+	return nil, fmt.Errorf("unknown Anomaly field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AnomalyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case anomaly.FieldCharacterID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCharacterID(v)
+		return nil
+	case anomaly.FieldSteamid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSteamid(v)
+		return nil
+	case anomaly.FieldServer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServer(v)
+		return nil
+	case anomaly.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case anomaly.FieldFindings:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFindings(v)
+		return nil
+	case anomaly.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case anomaly.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case anomaly.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case anomaly.FieldData:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case anomaly.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case anomaly.FieldReviewedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewedAt(v)
+		return nil
+	case anomaly.FieldReviewedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Anomaly field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AnomalyMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, anomaly.FieldVersion)
+	}
+	if m.addsize != nil {
+		fields = append(fields, anomaly.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AnomalyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case anomaly.FieldVersion:
+		return m.AddedVersion()
+	case anomaly.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AnomalyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case anomaly.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case anomaly.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Anomaly numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AnomalyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(anomaly.FieldData) {
+		fields = append(fields, anomaly.FieldData)
+	}
+	if m.FieldCleared(anomaly.FieldReviewedAt) {
+		fields = append(fields, anomaly.FieldReviewedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AnomalyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AnomalyMutation) ClearField(name string) error {
+	switch name {
+	case anomaly.FieldData:
+		m.ClearData()
+		return nil
+	case anomaly.FieldReviewedAt:
+		m.ClearReviewedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Anomaly nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AnomalyMutation) ResetField(name string) error {
+	switch name {
+	case anomaly.FieldCharacterID:
+		m.ResetCharacterID()
+		return nil
+	case anomaly.FieldSteamid:
+		m.ResetSteamid()
+		return nil
+	case anomaly.FieldServer:
+		m.ResetServer()
+		return nil
+	case anomaly.FieldAction:
+		m.ResetAction()
+		return nil
+	case anomaly.FieldFindings:
+		m.ResetFindings()
+		return nil
+	case anomaly.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case anomaly.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case anomaly.FieldSize:
+		m.ResetSize()
+		return nil
+	case anomaly.FieldData:
+		m.ResetData()
+		return nil
+	case anomaly.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case anomaly.FieldReviewedAt:
+		m.ResetReviewedAt()
+		return nil
+	case anomaly.FieldReviewedBy:
+		m.ResetReviewedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Anomaly field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AnomalyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AnomalyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AnomalyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AnomalyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AnomalyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AnomalyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AnomalyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Anomaly unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AnomalyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Anomaly edge %s", name)
+}
+
+// AuditEventMutation represents an operation that mutates the AuditEvent nodes in the graph.
+type AuditEventMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	actor          *string
+	server         *string
+	action         *string
+	steamid        *string
+	character_id   *uuid.UUID
+	size_before    *int
+	addsize_before *int
+	size_after     *int
+	addsize_after  *int
+	request_id     *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*AuditEvent, error)
+	predicates     []predicate.AuditEvent
+}
+
+var _ ent.Mutation = (*AuditEventMutation)(nil)
+
+// auditeventOption allows management of the mutation configuration using functional options.
+type auditeventOption func(*AuditEventMutation)
+
+// newAuditEventMutation creates new mutation for the AuditEvent entity.
+func newAuditEventMutation(c config, op Op, opts ...auditeventOption) *AuditEventMutation {
+	m := &AuditEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAuditEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAuditEventID sets the ID field of the mutation.
+func withAuditEventID(id uuid.UUID) auditeventOption {
+	return func(m *AuditEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AuditEvent
+		)
+		m.oldValue = func(ctx context.Context) (*AuditEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AuditEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAuditEvent sets the old AuditEvent of the mutation.
+func withAuditEvent(node *AuditEvent) auditeventOption {
+	return func(m *AuditEventMutation) {
+		m.oldValue = func(context.Context) (*AuditEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AuditEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AuditEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AuditEvent entities.
+func (m *AuditEventMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AuditEventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AuditEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AuditEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetActor sets the "actor" field.
+func (m *AuditEventMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *AuditEventMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *AuditEventMutation) ResetActor() {
+	m.actor = nil
+}
+
+// SetServer sets the "server" field.
+func (m *AuditEventMutation) SetServer(s string) {
+	m.server = &s
+}
+
+// Server returns the value of the "server" field in the mutation.
+func (m *AuditEventMutation) Server() (r string, exists bool) {
+	v := m.server
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServer returns the old "server" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldServer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServer: %w", err)
+	}
+	return oldValue.Server, nil
+}
+
+// ResetServer resets all changes to the "server" field.
+func (m *AuditEventMutation) ResetServer() {
+	m.server = nil
+}
+
+// SetAction sets the "action" field.
+func (m *AuditEventMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditEventMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *AuditEventMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetSteamid sets the "steamid" field.
+func (m *AuditEventMutation) SetSteamid(s string) {
+	m.steamid = &s
+}
+
+// Steamid returns the value of the "steamid" field in the mutation.
+func (m *AuditEventMutation) Steamid() (r string, exists bool) {
+	v := m.steamid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSteamid returns the old "steamid" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldSteamid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSteamid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSteamid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSteamid: %w", err)
+	}
+	return oldValue.Steamid, nil
+}
+
+// ResetSteamid resets all changes to the "steamid" field.
+func (m *AuditEventMutation) ResetSteamid() {
+	m.steamid = nil
+}
+
+// SetCharacterID sets the "character_id" field.
+func (m *AuditEventMutation) SetCharacterID(u uuid.UUID) {
+	m.character_id = &u
+}
+
+// CharacterID returns the value of the "character_id" field in the mutation.
+func (m *AuditEventMutation) CharacterID() (r uuid.UUID, exists bool) {
+	v := m.character_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCharacterID returns the old "character_id" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldCharacterID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCharacterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCharacterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCharacterID: %w", err)
+	}
+	return oldValue.CharacterID, nil
+}
+
+// ClearCharacterID clears the value of the "character_id" field.
+func (m *AuditEventMutation) ClearCharacterID() {
+	m.character_id = nil
+	m.clearedFields[auditevent.FieldCharacterID] = struct{}{}
+}
+
+// CharacterIDCleared returns if the "character_id" field was cleared in this mutation.
+func (m *AuditEventMutation) CharacterIDCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldCharacterID]
+	return ok
+}
+
+// ResetCharacterID resets all changes to the "character_id" field.
+func (m *AuditEventMutation) ResetCharacterID() {
+	m.character_id = nil
+	delete(m.clearedFields, auditevent.FieldCharacterID)
+}
+
+// SetSizeBefore sets the "size_before" field.
+func (m *AuditEventMutation) SetSizeBefore(i int) {
+	m.size_before = &i
+	m.addsize_before = nil
+}
+
+// SizeBefore returns the value of the "size_before" field in the mutation.
+func (m *AuditEventMutation) SizeBefore() (r int, exists bool) {
+	v := m.size_before
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSizeBefore returns the old "size_before" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldSizeBefore(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSizeBefore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSizeBefore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSizeBefore: %w", err)
+	}
+	return oldValue.SizeBefore, nil
+}
+
+// AddSizeBefore adds i to the "size_before" field.
+func (m *AuditEventMutation) AddSizeBefore(i int) {
+	if m.addsize_before != nil {
+		*m.addsize_before += i
+	} else {
+		m.addsize_before = &i
+	}
+}
+
+// AddedSizeBefore returns the value that was added to the "size_before" field in this mutation.
+func (m *AuditEventMutation) AddedSizeBefore() (r int, exists bool) {
+	v := m.addsize_before
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSizeBefore clears the value of the "size_before" field.
+func (m *AuditEventMutation) ClearSizeBefore() {
+	m.size_before = nil
+	m.addsize_before = nil
+	m.clearedFields[auditevent.FieldSizeBefore] = struct{}{}
+}
+
+// SizeBeforeCleared returns if the "size_before" field was cleared in this mutation.
+func (m *AuditEventMutation) SizeBeforeCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldSizeBefore]
+	return ok
+}
+
+// ResetSizeBefore resets all changes to the "size_before" field.
+func (m *AuditEventMutation) ResetSizeBefore() {
+	m.size_before = nil
+	m.addsize_before = nil
+	delete(m.clearedFields, auditevent.FieldSizeBefore)
+}
+
+// SetSizeAfter sets the "size_after" field.
+func (m *AuditEventMutation) SetSizeAfter(i int) {
+	m.size_after = &i
+	m.addsize_after = nil
+}
+
+// SizeAfter returns the value of the "size_after" field in the mutation.
+func (m *AuditEventMutation) SizeAfter() (r int, exists bool) {
+	v := m.size_after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSizeAfter returns the old "size_after" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldSizeAfter(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSizeAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSizeAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSizeAfter: %w", err)
+	}
+	return oldValue.SizeAfter, nil
+}
+
+// AddSizeAfter adds i to the "size_after" field.
+func (m *AuditEventMutation) AddSizeAfter(i int) {
+	if m.addsize_after != nil {
+		*m.addsize_after += i
+	} else {
+		m.addsize_after = &i
+	}
+}
+
+// AddedSizeAfter returns the value that was added to the "size_after" field in this mutation.
+func (m *AuditEventMutation) AddedSizeAfter() (r int, exists bool) {
+	v := m.addsize_after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSizeAfter clears the value of the "size_after" field.
+func (m *AuditEventMutation) ClearSizeAfter() {
+	m.size_after = nil
+	m.addsize_after = nil
+	m.clearedFields[auditevent.FieldSizeAfter] = struct{}{}
+}
+
+// SizeAfterCleared returns if the "size_after" field was cleared in this mutation.
+func (m *AuditEventMutation) SizeAfterCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldSizeAfter]
+	return ok
+}
+
+// ResetSizeAfter resets all changes to the "size_after" field.
+func (m *AuditEventMutation) ResetSizeAfter() {
+	m.size_after = nil
+	m.addsize_after = nil
+	delete(m.clearedFields, auditevent.FieldSizeAfter)
+}
+
+// SetRequestID sets the "request_id" field.
+func (m *AuditEventMutation) SetRequestID(s string) {
+	m.request_id = &s
+}
+
+// RequestID returns the value of the "request_id" field in the mutation.
+func (m *AuditEventMutation) RequestID() (r string, exists bool) {
+	v := m.request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestID returns the old "request_id" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldRequestID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
+	}
+	return oldValue.RequestID, nil
+}
+
+// ResetRequestID resets all changes to the "request_id" field.
+func (m *AuditEventMutation) ResetRequestID() {
+	m.request_id = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the AuditEventMutation builder.
+func (m *AuditEventMutation) Where(ps ...predicate.AuditEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *AuditEventMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (AuditEvent).
+func (m *AuditEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditEventMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.actor != nil {
+		fields = append(fields, auditevent.FieldActor)
+	}
+	if m.server != nil {
+		fields = append(fields, auditevent.FieldServer)
+	}
+	if m.action != nil {
+		fields = append(fields, auditevent.FieldAction)
+	}
+	if m.steamid != nil {
+		fields = append(fields, auditevent.FieldSteamid)
+	}
+	if m.character_id != nil {
+		fields = append(fields, auditevent.FieldCharacterID)
+	}
+	if m.size_before != nil {
+		fields = append(fields, auditevent.FieldSizeBefore)
+	}
+	if m.size_after != nil {
+		fields = append(fields, auditevent.FieldSizeAfter)
+	}
+	if m.request_id != nil {
+		fields = append(fields, auditevent.FieldRequestID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, auditevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditevent.FieldActor:
+		return m.Actor()
+	case auditevent.FieldServer:
+		return m.Server()
+	case auditevent.FieldAction:
+		return m.Action()
+	case auditevent.FieldSteamid:
+		return m.Steamid()
+	case auditevent.FieldCharacterID:
+		return m.CharacterID()
+	case auditevent.FieldSizeBefore:
+		return m.SizeBefore()
+	case auditevent.FieldSizeAfter:
+		return m.SizeAfter()
+	case auditevent.FieldRequestID:
+		return m.RequestID()
+	case auditevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditevent.FieldActor:
+		return m.OldActor(ctx)
+	case auditevent.FieldServer:
+		return m.OldServer(ctx)
+	case auditevent.FieldAction:
+		return m.OldAction(ctx)
+	case auditevent.FieldSteamid:
+		return m.OldSteamid(ctx)
+	case auditevent.FieldCharacterID:
+		return m.OldCharacterID(ctx)
+	case auditevent.FieldSizeBefore:
+		return m.OldSizeBefore(ctx)
+	case auditevent.FieldSizeAfter:
+		return m.OldSizeAfter(ctx)
+	case auditevent.FieldRequestID:
+		return m.OldRequestID(ctx)
+	case auditevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditevent.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case auditevent.FieldServer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServer(v)
+		return nil
+	case auditevent.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case auditevent.FieldSteamid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSteamid(v)
+		return nil
+	case auditevent.FieldCharacterID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCharacterID(v)
+		return nil
+	case auditevent.FieldSizeBefore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSizeBefore(v)
+		return nil
+	case auditevent.FieldSizeAfter:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSizeAfter(v)
+		return nil
+	case auditevent.FieldRequestID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestID(v)
+		return nil
+	case auditevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize_before != nil {
+		fields = append(fields, auditevent.FieldSizeBefore)
+	}
+	if m.addsize_after != nil {
+		fields = append(fields, auditevent.FieldSizeAfter)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditevent.FieldSizeBefore:
+		return m.AddedSizeBefore()
+	case auditevent.FieldSizeAfter:
+		return m.AddedSizeAfter()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case auditevent.FieldSizeBefore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSizeBefore(v)
+		return nil
+	case auditevent.FieldSizeAfter:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSizeAfter(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditevent.FieldCharacterID) {
+		fields = append(fields, auditevent.FieldCharacterID)
+	}
+	if m.FieldCleared(auditevent.FieldSizeBefore) {
+		fields = append(fields, auditevent.FieldSizeBefore)
+	}
+	if m.FieldCleared(auditevent.FieldSizeAfter) {
+		fields = append(fields, auditevent.FieldSizeAfter)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditEventMutation) ClearField(name string) error {
+	switch name {
+	case auditevent.FieldCharacterID:
+		m.ClearCharacterID()
+		return nil
+	case auditevent.FieldSizeBefore:
+		m.ClearSizeBefore()
+		return nil
+	case auditevent.FieldSizeAfter:
+		m.ClearSizeAfter()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditEventMutation) ResetField(name string) error {
+	switch name {
+	case auditevent.FieldActor:
+		m.ResetActor()
+		return nil
+	case auditevent.FieldServer:
+		m.ResetServer()
+		return nil
+	case auditevent.FieldAction:
+		m.ResetAction()
+		return nil
+	case auditevent.FieldSteamid:
+		m.ResetSteamid()
+		return nil
+	case auditevent.FieldCharacterID:
+		m.ResetCharacterID()
+		return nil
+	case auditevent.FieldSizeBefore:
+		m.ResetSizeBefore()
+		return nil
+	case auditevent.FieldSizeAfter:
+		m.ResetSizeAfter()
+		return nil
+	case auditevent.FieldRequestID:
+		m.ResetRequestID()
+		return nil
+	case auditevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent edge %s", name)
+}
+
+// BanMutation represents an operation that mutates the Ban nodes in the graph.
+type BanMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	steamid       *string
+	reason        *string
+	issued_by     *string
+	created_at    *time.Time
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Ban, error)
+	predicates    []predicate.Ban
+}
+
+var _ ent.Mutation = (*BanMutation)(nil)
+
+// banOption allows management of the mutation configuration using functional options.
+type banOption func(*BanMutation)
+
+// newBanMutation creates new mutation for the Ban entity.
+func newBanMutation(c config, op Op, opts ...banOption) *BanMutation {
+	m := &BanMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBan,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBanID sets the ID field of the mutation.
+func withBanID(id uuid.UUID) banOption {
+	return func(m *BanMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Ban
+		)
+		m.oldValue = func(ctx context.Context) (*Ban, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Ban.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBan sets the old Ban of the mutation.
+func withBan(node *Ban) banOption {
+	return func(m *BanMutation) {
+		m.oldValue = func(context.Context) (*Ban, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BanMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BanMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Ban entities.
+func (m *BanMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BanMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BanMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Ban.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSteamid sets the "steamid" field.
+func (m *BanMutation) SetSteamid(s string) {
+	m.steamid = &s
+}
+
+// Steamid returns the value of the "steamid" field in the mutation.
+func (m *BanMutation) Steamid() (r string, exists bool) {
+	v := m.steamid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSteamid returns the old "steamid" field's value of the Ban entity.
+// If the Ban object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BanMutation) OldSteamid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSteamid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSteamid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSteamid: %w", err)
+	}
+	return oldValue.Steamid, nil
+}
+
+// ResetSteamid resets all changes to the "steamid" field.
+func (m *BanMutation) ResetSteamid() {
+	m.steamid = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *BanMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *BanMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the Ban entity.
+// If the Ban object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BanMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *BanMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetIssuedBy sets the "issued_by" field.
+func (m *BanMutation) SetIssuedBy(s string) {
+	m.issued_by = &s
+}
+
+// IssuedBy returns the value of the "issued_by" field in the mutation.
+func (m *BanMutation) IssuedBy() (r string, exists bool) {
+	v := m.issued_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuedBy returns the old "issued_by" field's value of the Ban entity.
+// If the Ban object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BanMutation) OldIssuedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuedBy: %w", err)
+	}
+	return oldValue.IssuedBy, nil
+}
+
+// ResetIssuedBy resets all changes to the "issued_by" field.
+func (m *BanMutation) ResetIssuedBy() {
+	m.issued_by = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BanMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BanMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Ban entity.
+// If the Ban object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BanMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BanMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *BanMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *BanMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Ban entity.
+// If the Ban object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BanMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *BanMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[ban.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *BanMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[ban.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *BanMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, ban.FieldExpiresAt)
+}
+
+// Where appends a list predicates to the BanMutation builder.
+func (m *BanMutation) Where(ps ...predicate.Ban) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *BanMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Ban).
+func (m *BanMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BanMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.steamid != nil {
+		fields = append(fields, ban.FieldSteamid)
+	}
+	if m.reason != nil {
+		fields = append(fields, ban.FieldReason)
+	}
+	if m.issued_by != nil {
+		fields = append(fields, ban.FieldIssuedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, ban.FieldCreatedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, ban.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BanMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ban.FieldSteamid:
+		return m.Steamid()
+	case ban.FieldReason:
+		return m.Reason()
+	case ban.FieldIssuedBy:
+		return m.IssuedBy()
+	case ban.FieldCreatedAt:
+		return m.CreatedAt()
+	case ban.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ban.FieldSteamid:
+		return m.OldSteamid(ctx)
+	case ban.FieldReason:
+		return m.OldReason(ctx)
+	case ban.FieldIssuedBy:
+		return m.OldIssuedBy(ctx)
+	case ban.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case ban.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Ban field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BanMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ban.FieldSteamid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSteamid(v)
+		return nil
+	case ban.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case ban.FieldIssuedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuedBy(v)
+		return nil
+	case ban.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case ban.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Ban field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BanMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BanMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BanMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Ban numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BanMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ban.FieldExpiresAt) {
+		fields = append(fields, ban.FieldExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BanMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BanMutation) ClearField(name string) error {
+	switch name {
+	case ban.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Ban nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BanMutation) ResetField(name string) error {
+	switch name {
+	case ban.FieldSteamid:
+		m.ResetSteamid()
+		return nil
+	case ban.FieldReason:
+		m.ResetReason()
+		return nil
+	case ban.FieldIssuedBy:
+		m.ResetIssuedBy()
+		return nil
+	case ban.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case ban.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Ban field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BanMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BanMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BanMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BanMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BanMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BanMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BanMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Ban unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BanMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Ban edge %s", name)
+}
+
+// CharacterMutation represents an operation that mutates the Character nodes in the graph.
+type CharacterMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	steamid         *string
+	slot            *int
+	addslot         *int
+	size            *int
+	addsize         *int
+	data            *string
+	deleted_at      *time.Time
+	live            *bool
+	created_at      *time.Time
+	updated_at      *time.Time
+	version         *int
+	addversion      *int
+	clearedFields   map[string]struct{}
+	versions        map[uuid.UUID]struct{}
+	removedversions map[uuid.UUID]struct{}
+	clearedversions bool
+	done            bool
+	oldValue        func(context.Context) (*Character, error)
+	predicates      []predicate.Character
+}
+
+var _ ent.Mutation = (*CharacterMutation)(nil)
+
+// characterOption allows management of the mutation configuration using functional options.
+type characterOption func(*CharacterMutation)
+
+// newCharacterMutation creates new mutation for the Character entity.
+func newCharacterMutation(c config, op Op, opts ...characterOption) *CharacterMutation {
+	m := &CharacterMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCharacter,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCharacterID sets the ID field of the mutation.
+func withCharacterID(id uuid.UUID) characterOption {
+	return func(m *CharacterMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Character
+		)
+		m.oldValue = func(ctx context.Context) (*Character, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Character.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCharacter sets the old Character of the mutation.
+func withCharacter(node *Character) characterOption {
+	return func(m *CharacterMutation) {
+		m.oldValue = func(context.Context) (*Character, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CharacterMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CharacterMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Character entities.
+func (m *CharacterMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CharacterMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CharacterMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Character.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSteamid sets the "steamid" field.
+func (m *CharacterMutation) SetSteamid(s string) {
+	m.steamid = &s
+}
+
+// Steamid returns the value of the "steamid" field in the mutation.
+func (m *CharacterMutation) Steamid() (r string, exists bool) {
+	v := m.steamid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSteamid returns the old "steamid" field's value of the Character entity.
+// If the Character object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterMutation) OldSteamid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSteamid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSteamid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSteamid: %w", err)
+	}
+	return oldValue.Steamid, nil
+}
+
+// ResetSteamid resets all changes to the "steamid" field.
+func (m *CharacterMutation) ResetSteamid() {
+	m.steamid = nil
+}
+
+// SetSlot sets the "slot" field.
+func (m *CharacterMutation) SetSlot(i int) {
+	m.slot = &i
+	m.addslot = nil
+}
+
+// Slot returns the value of the "slot" field in the mutation.
+func (m *CharacterMutation) Slot() (r int, exists bool) {
+	v := m.slot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlot returns the old "slot" field's value of the Character entity.
+// If the Character object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterMutation) OldSlot(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlot: %w", err)
+	}
+	return oldValue.Slot, nil
+}
+
+// AddSlot adds i to the "slot" field.
+func (m *CharacterMutation) AddSlot(i int) {
+	if m.addslot != nil {
+		*m.addslot += i
+	} else {
+		m.addslot = &i
+	}
+}
+
+// AddedSlot returns the value that was added to the "slot" field in this mutation.
+func (m *CharacterMutation) AddedSlot() (r int, exists bool) {
+	v := m.addslot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSlot resets all changes to the "slot" field.
+func (m *CharacterMutation) ResetSlot() {
+	m.slot = nil
+	m.addslot = nil
+}
+
+// SetSize sets the "size" field.
+func (m *CharacterMutation) SetSize(i int) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *CharacterMutation) Size() (r int, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the Character entity.
+// If the Character object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterMutation) OldSize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *CharacterMutation) AddSize(i int) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *CharacterMutation) AddedSize() (r int, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *CharacterMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetData sets the "data" field.
+func (m *CharacterMutation) SetData(s string) {
+	m.data = &s
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *CharacterMutation) Data() (r string, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the Character entity.
+// If the Character object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterMutation) OldData(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *CharacterMutation) ResetData() {
+	m.data = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *CharacterMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *CharacterMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Character entity.
+// If the Character object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *CharacterMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[character.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *CharacterMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[character.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *CharacterMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, character.FieldDeletedAt)
+}
+
+// SetLive sets the "live" field.
+func (m *CharacterMutation) SetLive(b bool) {
+	m.live = &b
+}
+
+// Live returns the value of the "live" field in the mutation.
+func (m *CharacterMutation) Live() (r bool, exists bool) {
+	v := m.live
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLive returns the old "live" field's value of the Character entity.
+// If the Character object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterMutation) OldLive(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLive: %w", err)
+	}
+	return oldValue.Live, nil
+}
+
+// ClearLive clears the value of the "live" field.
+func (m *CharacterMutation) ClearLive() {
+	m.live = nil
+	m.clearedFields[character.FieldLive] = struct{}{}
+}
+
+// LiveCleared returns if the "live" field was cleared in this mutation.
+func (m *CharacterMutation) LiveCleared() bool {
+	_, ok := m.clearedFields[character.FieldLive]
+	return ok
+}
+
+// ResetLive resets all changes to the "live" field.
+func (m *CharacterMutation) ResetLive() {
+	m.live = nil
+	delete(m.clearedFields, character.FieldLive)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CharacterMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CharacterMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Character entity.
+// If the Character object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *CharacterMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[character.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *CharacterMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[character.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CharacterMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, character.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CharacterMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CharacterMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Character entity.
+// If the Character object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *CharacterMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[character.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *CharacterMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[character.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CharacterMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, character.FieldUpdatedAt)
+}
+
+// SetVersion sets the "version" field.
+func (m *CharacterMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *CharacterMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Character entity.
+// If the Character object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *CharacterMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *CharacterMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearVersion clears the value of the "version" field.
+func (m *CharacterMutation) ClearVersion() {
+	m.version = nil
+	m.addversion = nil
+	m.clearedFields[character.FieldVersion] = struct{}{}
+}
+
+// VersionCleared returns if the "version" field was cleared in this mutation.
+func (m *CharacterMutation) VersionCleared() bool {
+	_, ok := m.clearedFields[character.FieldVersion]
+	return ok
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *CharacterMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+	delete(m.clearedFields, character.FieldVersion)
+}
+
+// AddVersionIDs adds the "versions" edge to the CharacterVersion entity by ids.
+func (m *CharacterMutation) AddVersionIDs(ids ...uuid.UUID) {
+	if m.versions == nil {
+		m.versions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.versions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVersions clears the "versions" edge to the CharacterVersion entity.
+func (m *CharacterMutation) ClearVersions() {
+	m.clearedversions = true
+}
+
+// VersionsCleared reports if the "versions" edge to the CharacterVersion entity was cleared.
+func (m *CharacterMutation) VersionsCleared() bool {
+	return m.clearedversions
+}
+
+// RemoveVersionIDs removes the "versions" edge to the CharacterVersion entity by IDs.
+func (m *CharacterMutation) RemoveVersionIDs(ids ...uuid.UUID) {
+	if m.removedversions == nil {
+		m.removedversions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.versions, ids[i])
+		m.removedversions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVersions returns the removed IDs of the "versions" edge to the CharacterVersion entity.
+func (m *CharacterMutation) RemovedVersionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedversions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VersionsIDs returns the "versions" edge IDs in the mutation.
+func (m *CharacterMutation) VersionsIDs() (ids []uuid.UUID) {
+	for id := range m.versions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVersions resets all changes to the "versions" edge.
+func (m *CharacterMutation) ResetVersions() {
+	m.versions = nil
+	m.clearedversions = false
+	m.removedversions = nil
+}
+
+// Where appends a list predicates to the CharacterMutation builder.
+func (m *CharacterMutation) Where(ps ...predicate.Character) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *CharacterMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Character).
+func (m *CharacterMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CharacterMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.steamid != nil {
+		fields = append(fields, character.FieldSteamid)
+	}
+	if m.slot != nil {
+		fields = append(fields, character.FieldSlot)
+	}
+	if m.size != nil {
+		fields = append(fields, character.FieldSize)
+	}
+	if m.data != nil {
+		fields = append(fields, character.FieldData)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, character.FieldDeletedAt)
+	}
+	if m.live != nil {
+		fields = append(fields, character.FieldLive)
+	}
+	if m.created_at != nil {
+		fields = append(fields, character.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, character.FieldUpdatedAt)
+	}
+	if m.version != nil {
+		fields = append(fields, character.FieldVersion)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CharacterMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case character.FieldSteamid:
+		return m.Steamid()
+	case character.FieldSlot:
+		return m.Slot()
+	case character.FieldSize:
+		return m.Size()
+	case character.FieldData:
+		return m.Data()
+	case character.FieldDeletedAt:
+		return m.DeletedAt()
+	case character.FieldLive:
+		return m.Live()
+	case character.FieldCreatedAt:
+		return m.CreatedAt()
+	case character.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case character.FieldVersion:
+		return m.Version()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CharacterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case character.FieldSteamid:
+		return m.OldSteamid(ctx)
+	case character.FieldSlot:
+		return m.OldSlot(ctx)
+	case character.FieldSize:
+		return m.OldSize(ctx)
+	case character.FieldData:
+		return m.OldData(ctx)
+	case character.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case character.FieldLive:
+		return m.OldLive(ctx)
+	case character.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case character.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case character.FieldVersion:
+		return m.OldVersion(ctx)
+	}
+	return nil, fmt.Errorf("unknown Character field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CharacterMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case character.FieldSteamid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSteamid(v)
+		return nil
+	case character.FieldSlot:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlot(v)
+		return nil
+	case character.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case character.FieldData:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case character.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case character.FieldLive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLive(v)
+		return nil
+	case character.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case character.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case character.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Character field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CharacterMutation) AddedFields() []string {
+	var fields []string
+	if m.addslot != nil {
+		fields = append(fields, character.FieldSlot)
+	}
+	if m.addsize != nil {
+		fields = append(fields, character.FieldSize)
+	}
+	if m.addversion != nil {
+		fields = append(fields, character.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CharacterMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case character.FieldSlot:
+		return m.AddedSlot()
+	case character.FieldSize:
+		return m.AddedSize()
+	case character.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CharacterMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case character.FieldSlot:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSlot(v)
+		return nil
+	case character.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case character.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Character numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CharacterMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(character.FieldDeletedAt) {
+		fields = append(fields, character.FieldDeletedAt)
+	}
+	if m.FieldCleared(character.FieldLive) {
+		fields = append(fields, character.FieldLive)
+	}
+	if m.FieldCleared(character.FieldCreatedAt) {
+		fields = append(fields, character.FieldCreatedAt)
+	}
+	if m.FieldCleared(character.FieldUpdatedAt) {
+		fields = append(fields, character.FieldUpdatedAt)
+	}
+	if m.FieldCleared(character.FieldVersion) {
+		fields = append(fields, character.FieldVersion)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CharacterMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CharacterMutation) ClearField(name string) error {
+	switch name {
+	case character.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case character.FieldLive:
+		m.ClearLive()
+		return nil
+	case character.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case character.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case character.FieldVersion:
+		m.ClearVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown Character nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CharacterMutation) ResetField(name string) error {
+	switch name {
+	case character.FieldSteamid:
+		m.ResetSteamid()
+		return nil
+	case character.FieldSlot:
+		m.ResetSlot()
+		return nil
+	case character.FieldSize:
+		m.ResetSize()
+		return nil
+	case character.FieldData:
+		m.ResetData()
+		return nil
+	case character.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case character.FieldLive:
+		m.ResetLive()
+		return nil
+	case character.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case character.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case character.FieldVersion:
+		m.ResetVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown Character field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CharacterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.versions != nil {
+		edges = append(edges, character.EdgeVersions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CharacterMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case character.EdgeVersions:
+		ids := make([]ent.Value, 0, len(m.versions))
+		for id := range m.versions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CharacterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedversions != nil {
+		edges = append(edges, character.EdgeVersions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CharacterMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case character.EdgeVersions:
+		ids := make([]ent.Value, 0, len(m.removedversions))
+		for id := range m.removedversions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CharacterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedversions {
+		edges = append(edges, character.EdgeVersions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CharacterMutation) EdgeCleared(name string) bool {
+	switch name {
+	case character.EdgeVersions:
+		return m.clearedversions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CharacterMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Character unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CharacterMutation) ResetEdge(name string) error {
+	switch name {
+	case character.EdgeVersions:
+		m.ResetVersions()
+		return nil
+	}
+	return fmt.Errorf("unknown Character edge %s", name)
+}
+
+// CharacterLockMutation represents an operation that mutates the CharacterLock nodes in the graph.
+type CharacterLockMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	character_id  *uuid.UUID
+	server        *string
+	expires_at    *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*CharacterLock, error)
+	predicates    []predicate.CharacterLock
+}
+
+var _ ent.Mutation = (*CharacterLockMutation)(nil)
+
+// characterlockOption allows management of the mutation configuration using functional options.
+type characterlockOption func(*CharacterLockMutation)
+
+// newCharacterLockMutation creates new mutation for the CharacterLock entity.
+func newCharacterLockMutation(c config, op Op, opts ...characterlockOption) *CharacterLockMutation {
+	m := &CharacterLockMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCharacterLock,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCharacterLockID sets the ID field of the mutation.
+func withCharacterLockID(id uuid.UUID) characterlockOption {
+	return func(m *CharacterLockMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CharacterLock
+		)
+		m.oldValue = func(ctx context.Context) (*CharacterLock, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CharacterLock.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCharacterLock sets the old CharacterLock of the mutation.
+func withCharacterLock(node *CharacterLock) characterlockOption {
+	return func(m *CharacterLockMutation) {
+		m.oldValue = func(context.Context) (*CharacterLock, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CharacterLockMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CharacterLockMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CharacterLock entities.
+func (m *CharacterLockMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CharacterLockMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CharacterLockMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CharacterLock.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCharacterID sets the "character_id" field.
+func (m *CharacterLockMutation) SetCharacterID(u uuid.UUID) {
+	m.character_id = &u
+}
+
+// CharacterID returns the value of the "character_id" field in the mutation.
+func (m *CharacterLockMutation) CharacterID() (r uuid.UUID, exists bool) {
+	v := m.character_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCharacterID returns the old "character_id" field's value of the CharacterLock entity.
+// If the CharacterLock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterLockMutation) OldCharacterID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCharacterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCharacterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCharacterID: %w", err)
+	}
+	return oldValue.CharacterID, nil
+}
+
+// ResetCharacterID resets all changes to the "character_id" field.
+func (m *CharacterLockMutation) ResetCharacterID() {
+	m.character_id = nil
+}
+
+// SetServer sets the "server" field.
+func (m *CharacterLockMutation) SetServer(s string) {
+	m.server = &s
+}
+
+// Server returns the value of the "server" field in the mutation.
+func (m *CharacterLockMutation) Server() (r string, exists bool) {
+	v := m.server
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServer returns the old "server" field's value of the CharacterLock entity.
+// If the CharacterLock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterLockMutation) OldServer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServer: %w", err)
+	}
+	return oldValue.Server, nil
+}
+
+// ResetServer resets all changes to the "server" field.
+func (m *CharacterLockMutation) ResetServer() {
+	m.server = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *CharacterLockMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *CharacterLockMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the CharacterLock entity.
+// If the CharacterLock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterLockMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *CharacterLockMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CharacterLockMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CharacterLockMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CharacterLock entity.
+// If the CharacterLock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterLockMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CharacterLockMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the CharacterLockMutation builder.
+func (m *CharacterLockMutation) Where(ps ...predicate.CharacterLock) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *CharacterLockMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (CharacterLock).
+func (m *CharacterLockMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CharacterLockMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.character_id != nil {
+		fields = append(fields, characterlock.FieldCharacterID)
+	}
+	if m.server != nil {
+		fields = append(fields, characterlock.FieldServer)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, characterlock.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, characterlock.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CharacterLockMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case characterlock.FieldCharacterID:
+		return m.CharacterID()
+	case characterlock.FieldServer:
+		return m.Server()
+	case characterlock.FieldExpiresAt:
+		return m.ExpiresAt()
+	case characterlock.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CharacterLockMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case characterlock.FieldCharacterID:
+		return m.OldCharacterID(ctx)
+	case characterlock.FieldServer:
+		return m.OldServer(ctx)
+	case characterlock.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case characterlock.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CharacterLock field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CharacterLockMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case characterlock.FieldCharacterID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCharacterID(v)
+		return nil
+	case characterlock.FieldServer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServer(v)
+		return nil
+	case characterlock.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case characterlock.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CharacterLock field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CharacterLockMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CharacterLockMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CharacterLockMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CharacterLock numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CharacterLockMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CharacterLockMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CharacterLockMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CharacterLock nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CharacterLockMutation) ResetField(name string) error {
+	switch name {
+	case characterlock.FieldCharacterID:
+		m.ResetCharacterID()
+		return nil
+	case characterlock.FieldServer:
+		m.ResetServer()
+		return nil
+	case characterlock.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case characterlock.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CharacterLock field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CharacterLockMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CharacterLockMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CharacterLockMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CharacterLockMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CharacterLockMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CharacterLockMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CharacterLockMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CharacterLock unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CharacterLockMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CharacterLock edge %s", name)
+}
+
+// CharacterVersionMutation represents an operation that mutates the CharacterVersion nodes in the graph.
+type CharacterVersionMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	version          *int
+	addversion       *int
+	size             *int
+	addsize          *int
+	data             *string
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	character        *uuid.UUID
+	clearedcharacter bool
+	done             bool
+	oldValue         func(context.Context) (*CharacterVersion, error)
+	predicates       []predicate.CharacterVersion
+}
+
+var _ ent.Mutation = (*CharacterVersionMutation)(nil)
+
+// characterversionOption allows management of the mutation configuration using functional options.
+type characterversionOption func(*CharacterVersionMutation)
+
+// newCharacterVersionMutation creates new mutation for the CharacterVersion entity.
+func newCharacterVersionMutation(c config, op Op, opts ...characterversionOption) *CharacterVersionMutation {
+	m := &CharacterVersionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCharacterVersion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCharacterVersionID sets the ID field of the mutation.
+func withCharacterVersionID(id uuid.UUID) characterversionOption {
+	return func(m *CharacterVersionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CharacterVersion
+		)
+		m.oldValue = func(ctx context.Context) (*CharacterVersion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CharacterVersion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCharacterVersion sets the old CharacterVersion of the mutation.
+func withCharacterVersion(node *CharacterVersion) characterversionOption {
+	return func(m *CharacterVersionMutation) {
+		m.oldValue = func(context.Context) (*CharacterVersion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CharacterVersionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CharacterVersionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CharacterVersion entities.
+func (m *CharacterVersionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CharacterVersionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CharacterVersionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CharacterVersion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCharacterID sets the "character_id" field.
+func (m *CharacterVersionMutation) SetCharacterID(u uuid.UUID) {
+	m.character = &u
+}
+
+// CharacterID returns the value of the "character_id" field in the mutation.
+func (m *CharacterVersionMutation) CharacterID() (r uuid.UUID, exists bool) {
+	v := m.character
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCharacterID returns the old "character_id" field's value of the CharacterVersion entity.
+// If the CharacterVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterVersionMutation) OldCharacterID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCharacterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCharacterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCharacterID: %w", err)
+	}
+	return oldValue.CharacterID, nil
+}
+
+// ResetCharacterID resets all changes to the "character_id" field.
+func (m *CharacterVersionMutation) ResetCharacterID() {
+	m.character = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *CharacterVersionMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *CharacterVersionMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the CharacterVersion entity.
+// If the CharacterVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterVersionMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *CharacterVersionMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *CharacterVersionMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *CharacterVersionMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetSize sets the "size" field.
+func (m *CharacterVersionMutation) SetSize(i int) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *CharacterVersionMutation) Size() (r int, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the CharacterVersion entity.
+// If the CharacterVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterVersionMutation) OldSize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *CharacterVersionMutation) AddSize(i int) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *CharacterVersionMutation) AddedSize() (r int, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *CharacterVersionMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetData sets the "data" field.
+func (m *CharacterVersionMutation) SetData(s string) {
+	m.data = &s
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *CharacterVersionMutation) Data() (r string, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the CharacterVersion entity.
+// If the CharacterVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterVersionMutation) OldData(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *CharacterVersionMutation) ResetData() {
+	m.data = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CharacterVersionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CharacterVersionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CharacterVersion entity.
+// If the CharacterVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CharacterVersionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CharacterVersionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearCharacter clears the "character" edge to the Character entity.
+func (m *CharacterVersionMutation) ClearCharacter() {
+	m.clearedcharacter = true
+}
+
+// CharacterCleared reports if the "character" edge to the Character entity was cleared.
+func (m *CharacterVersionMutation) CharacterCleared() bool {
+	return m.clearedcharacter
+}
+
+// CharacterIDs returns the "character" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CharacterID instead. It exists only for internal usage by the builders.
+func (m *CharacterVersionMutation) CharacterIDs() (ids []uuid.UUID) {
+	if id := m.character; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCharacter resets all changes to the "character" edge.
+func (m *CharacterVersionMutation) ResetCharacter() {
+	m.character = nil
+	m.clearedcharacter = false
+}
+
+// Where appends a list predicates to the CharacterVersionMutation builder.
+func (m *CharacterVersionMutation) Where(ps ...predicate.CharacterVersion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *CharacterVersionMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (CharacterVersion).
+func (m *CharacterVersionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CharacterVersionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.character != nil {
+		fields = append(fields, characterversion.FieldCharacterID)
+	}
+	if m.version != nil {
+		fields = append(fields, characterversion.FieldVersion)
+	}
+	if m.size != nil {
+		fields = append(fields, characterversion.FieldSize)
+	}
+	if m.data != nil {
+		fields = append(fields, characterversion.FieldData)
+	}
+	if m.created_at != nil {
+		fields = append(fields, characterversion.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CharacterVersionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case characterversion.FieldCharacterID:
+		return m.CharacterID()
+	case characterversion.FieldVersion:
+		return m.Version()
+	case characterversion.FieldSize:
+		return m.Size()
+	case characterversion.FieldData:
+		return m.Data()
+	case characterversion.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CharacterVersionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case characterversion.FieldCharacterID:
+		return m.OldCharacterID(ctx)
+	case characterversion.FieldVersion:
+		return m.OldVersion(ctx)
+	case characterversion.FieldSize:
+		return m.OldSize(ctx)
+	case characterversion.FieldData:
+		return m.OldData(ctx)
+	case characterversion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CharacterVersion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CharacterVersionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case characterversion.FieldCharacterID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCharacterID(v)
+		return nil
+	case characterversion.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case characterversion.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case characterversion.FieldData:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case characterversion.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CharacterVersion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CharacterVersionMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, characterversion.FieldVersion)
+	}
+	if m.addsize != nil {
+		fields = append(fields, characterversion.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CharacterVersionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case characterversion.FieldVersion:
+		return m.AddedVersion()
+	case characterversion.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CharacterVersionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case characterversion.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case characterversion.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CharacterVersion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CharacterVersionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CharacterVersionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CharacterVersionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CharacterVersion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CharacterVersionMutation) ResetField(name string) error {
+	switch name {
+	case characterversion.FieldCharacterID:
+		m.ResetCharacterID()
+		return nil
+	case characterversion.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case characterversion.FieldSize:
+		m.ResetSize()
+		return nil
+	case characterversion.FieldData:
+		m.ResetData()
+		return nil
+	case characterversion.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CharacterVersion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CharacterVersionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.character != nil {
+		edges = append(edges, characterversion.EdgeCharacter)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CharacterVersionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case characterversion.EdgeCharacter:
+		if id := m.character; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CharacterVersionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CharacterVersionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CharacterVersionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcharacter {
+		edges = append(edges, characterversion.EdgeCharacter)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CharacterVersionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case characterversion.EdgeCharacter:
+		return m.clearedcharacter
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CharacterVersionMutation) ClearEdge(name string) error {
+	switch name {
+	case characterversion.EdgeCharacter:
+		m.ClearCharacter()
+		return nil
+	}
+	return fmt.Errorf("unknown CharacterVersion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CharacterVersionMutation) ResetEdge(name string) error {
+	switch name {
+	case characterversion.EdgeCharacter:
+		m.ResetCharacter()
+		return nil
+	}
+	return fmt.Errorf("unknown CharacterVersion edge %s", name)
+}
+
+// ServerMutation represents an operation that mutates the Server nodes in the graph.
+type ServerMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	name           *string
+	address        *string
+	_map           *string
+	players        *int
+	addplayers     *int
+	max_players    *int
+	addmax_players *int
+	online         *bool
+	last_heartbeat *time.Time
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*Server, error)
+	predicates     []predicate.Server
+}
+
+var _ ent.Mutation = (*ServerMutation)(nil)
+
+// serverOption allows management of the mutation configuration using functional options.
+type serverOption func(*ServerMutation)
+
+// newServerMutation creates new mutation for the Server entity.
+func newServerMutation(c config, op Op, opts ...serverOption) *ServerMutation {
+	m := &ServerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeServer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withServerID sets the ID field of the mutation.
+func withServerID(id uuid.UUID) serverOption {
+	return func(m *ServerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Server
+		)
+		m.oldValue = func(ctx context.Context) (*Server, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Server.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withServer sets the old Server of the mutation.
+func withServer(node *Server) serverOption {
+	return func(m *ServerMutation) {
+		m.oldValue = func(context.Context) (*Server, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ServerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ServerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Server entities.
+func (m *ServerMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ServerMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ServerMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Server.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ServerMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ServerMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Server entity.
+// If the Server object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServerMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ServerMutation) ResetName() {
+	m.name = nil
+}
+
+// SetAddress sets the "address" field.
+func (m *ServerMutation) SetAddress(s string) {
+	m.address = &s
+}
+
+// Address returns the value of the "address" field in the mutation.
+func (m *ServerMutation) Address() (r string, exists bool) {
+	v := m.address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddress returns the old "address" field's value of the Server entity.
+// If the Server object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServerMutation) OldAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+	}
+	return oldValue.Address, nil
+}
+
+// ResetAddress resets all changes to the "address" field.
+func (m *ServerMutation) ResetAddress() {
+	m.address = nil
+}
+
+// SetMap sets the "map" field.
+func (m *ServerMutation) SetMap(s string) {
+	m._map = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *CharacterMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// Map returns the value of the "map" field in the mutation.
+func (m *ServerMutation) Map() (r string, exists bool) {
+	v := m._map
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *CharacterMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Character.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldMap returns the old "map" field's value of the Server entity.
+// If the Server object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServerMutation) OldMap(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMap is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMap requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMap: %w", err)
+	}
+	return oldValue.Map, nil
 }
 
-// SetSteamid sets the "steamid" field.
-func (m *CharacterMutation) SetSteamid(s string) {
-	m.steamid = &s
+// ResetMap resets all changes to the "map" field.
+func (m *ServerMutation) ResetMap() {
+	m._map = nil
 }
 
-// Steamid returns the value of the "steamid" field in the mutation.
-func (m *CharacterMutation) Steamid() (r string, exists bool) {
-	v := m.steamid
+// SetPlayers sets the "players" field.
+func (m *ServerMutation) SetPlayers(i int) {
+	m.players = &i
+	m.addplayers = nil
+}
+
+// Players returns the value of the "players" field in the mutation.
+func (m *ServerMutation) Players() (r int, exists bool) {
+	v := m.players
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSteamid returns the old "steamid" field's value of the Character entity.
-// If the Character object wasn't provided to the builder, the object is fetched from the database.
+// OldPlayers returns the old "players" field's value of the Server entity.
+// If the Server object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CharacterMutation) OldSteamid(ctx context.Context) (v string, err error) {
+func (m *ServerMutation) OldPlayers(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSteamid is only allowed on UpdateOne operations")
+		return v, errors.New("OldPlayers is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSteamid requires an ID field in the mutation")
+		return v, errors.New("OldPlayers requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSteamid: %w", err)
+		return v, fmt.Errorf("querying old value for OldPlayers: %w", err)
 	}
-	return oldValue.Steamid, nil
+	return oldValue.Players, nil
 }
 
-// ResetSteamid resets all changes to the "steamid" field.
-func (m *CharacterMutation) ResetSteamid() {
-	m.steamid = nil
+// AddPlayers adds i to the "players" field.
+func (m *ServerMutation) AddPlayers(i int) {
+	if m.addplayers != nil {
+		*m.addplayers += i
+	} else {
+		m.addplayers = &i
+	}
 }
 
-// SetSlot sets the "slot" field.
-func (m *CharacterMutation) SetSlot(i int) {
-	m.slot = &i
-	m.addslot = nil
+// AddedPlayers returns the value that was added to the "players" field in this mutation.
+func (m *ServerMutation) AddedPlayers() (r int, exists bool) {
+	v := m.addplayers
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Slot returns the value of the "slot" field in the mutation.
-func (m *CharacterMutation) Slot() (r int, exists bool) {
-	v := m.slot
+// ResetPlayers resets all changes to the "players" field.
+func (m *ServerMutation) ResetPlayers() {
+	m.players = nil
+	m.addplayers = nil
+}
+
+// SetMaxPlayers sets the "max_players" field.
+func (m *ServerMutation) SetMaxPlayers(i int) {
+	m.max_players = &i
+	m.addmax_players = nil
+}
+
+// MaxPlayers returns the value of the "max_players" field in the mutation.
+func (m *ServerMutation) MaxPlayers() (r int, exists bool) {
+	v := m.max_players
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlot returns the old "slot" field's value of the Character entity.
-// If the Character object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxPlayers returns the old "max_players" field's value of the Server entity.
+// If the Server object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CharacterMutation) OldSlot(ctx context.Context) (v int, err error) {
+func (m *ServerMutation) OldMaxPlayers(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlot is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxPlayers is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlot requires an ID field in the mutation")
+		return v, errors.New("OldMaxPlayers requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlot: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxPlayers: %w", err)
 	}
-	return oldValue.Slot, nil
+	return oldValue.MaxPlayers, nil
 }
 
-// AddSlot adds i to the "slot" field.
-func (m *CharacterMutation) AddSlot(i int) {
-	if m.addslot != nil {
-		*m.addslot += i
+// AddMaxPlayers adds i to the "max_players" field.
+func (m *ServerMutation) AddMaxPlayers(i int) {
+	if m.addmax_players != nil {
+		*m.addmax_players += i
 	} else {
-		m.addslot = &i
+		m.addmax_players = &i
 	}
 }
 
-// AddedSlot returns the value that was added to the "slot" field in this mutation.
-func (m *CharacterMutation) AddedSlot() (r int, exists bool) {
-	v := m.addslot
+// AddedMaxPlayers returns the value that was added to the "max_players" field in this mutation.
+func (m *ServerMutation) AddedMaxPlayers() (r int, exists bool) {
+	v := m.addmax_players
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSlot resets all changes to the "slot" field.
-func (m *CharacterMutation) ResetSlot() {
-	m.slot = nil
-	m.addslot = nil
+// ResetMaxPlayers resets all changes to the "max_players" field.
+func (m *ServerMutation) ResetMaxPlayers() {
+	m.max_players = nil
+	m.addmax_players = nil
 }
 
-// SetSize sets the "size" field.
-func (m *CharacterMutation) SetSize(i int) {
-	m.size = &i
-	m.addsize = nil
+// SetOnline sets the "online" field.
+func (m *ServerMutation) SetOnline(b bool) {
+	m.online = &b
 }
 
-// Size returns the value of the "size" field in the mutation.
-func (m *CharacterMutation) Size() (r int, exists bool) {
-	v := m.size
+// Online returns the value of the "online" field in the mutation.
+func (m *ServerMutation) Online() (r bool, exists bool) {
+	v := m.online
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSize returns the old "size" field's value of the Character entity.
-// If the Character object wasn't provided to the builder, the object is fetched from the database.
+// OldOnline returns the old "online" field's value of the Server entity.
+// If the Server object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CharacterMutation) OldSize(ctx context.Context) (v int, err error) {
+func (m *ServerMutation) OldOnline(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+		return v, errors.New("OldOnline is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSize requires an ID field in the mutation")
+		return v, errors.New("OldOnline requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+		return v, fmt.Errorf("querying old value for OldOnline: %w", err)
 	}
-	return oldValue.Size, nil
+	return oldValue.Online, nil
 }
 
-// AddSize adds i to the "size" field.
-func (m *CharacterMutation) AddSize(i int) {
-	if m.addsize != nil {
-		*m.addsize += i
-	} else {
-		m.addsize = &i
-	}
+// ResetOnline resets all changes to the "online" field.
+func (m *ServerMutation) ResetOnline() {
+	m.online = nil
 }
 
-// AddedSize returns the value that was added to the "size" field in this mutation.
-func (m *CharacterMutation) AddedSize() (r int, exists bool) {
-	v := m.addsize
+// SetLastHeartbeat sets the "last_heartbeat" field.
+func (m *ServerMutation) SetLastHeartbeat(t time.Time) {
+	m.last_heartbeat = &t
+}
+
+// LastHeartbeat returns the value of the "last_heartbeat" field in the mutation.
+func (m *ServerMutation) LastHeartbeat() (r time.Time, exists bool) {
+	v := m.last_heartbeat
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSize resets all changes to the "size" field.
-func (m *CharacterMutation) ResetSize() {
-	m.size = nil
-	m.addsize = nil
+// OldLastHeartbeat returns the old "last_heartbeat" field's value of the Server entity.
+// If the Server object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServerMutation) OldLastHeartbeat(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastHeartbeat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastHeartbeat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastHeartbeat: %w", err)
+	}
+	return oldValue.LastHeartbeat, nil
 }
 
-// SetData sets the "data" field.
-func (m *CharacterMutation) SetData(s string) {
-	m.data = &s
+// ResetLastHeartbeat resets all changes to the "last_heartbeat" field.
+func (m *ServerMutation) ResetLastHeartbeat() {
+	m.last_heartbeat = nil
 }
 
-// Data returns the value of the "data" field in the mutation.
-func (m *CharacterMutation) Data() (r string, exists bool) {
-	v := m.data
+// SetCreatedAt sets the "created_at" field.
+func (m *ServerMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ServerMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldData returns the old "data" field's value of the Character entity.
-// If the Character object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Server entity.
+// If the Server object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CharacterMutation) OldData(ctx context.Context) (v string, err error) {
+func (m *ServerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldData is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldData requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldData: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Data, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetData resets all changes to the "data" field.
-func (m *CharacterMutation) ResetData() {
-	m.data = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ServerMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the CharacterMutation builder.
-func (m *CharacterMutation) Where(ps ...predicate.Character) {
+// Where appends a list predicates to the ServerMutation builder.
+func (m *ServerMutation) Where(ps ...predicate.Server) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *CharacterMutation) Op() Op {
+func (m *ServerMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Character).
-func (m *CharacterMutation) Type() string {
+// Type returns the node type of this mutation (Server).
+func (m *ServerMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CharacterMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.steamid != nil {
-		fields = append(fields, character.FieldSteamid)
+func (m *ServerMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, server.FieldName)
 	}
-	if m.slot != nil {
-		fields = append(fields, character.FieldSlot)
+	if m.address != nil {
+		fields = append(fields, server.FieldAddress)
 	}
-	if m.size != nil {
-		fields = append(fields, character.FieldSize)
+	if m._map != nil {
+		fields = append(fields, server.FieldMap)
 	}
-	if m.data != nil {
-		fields = append(fields, character.FieldData)
+	if m.players != nil {
+		fields = append(fields, server.FieldPlayers)
+	}
+	if m.max_players != nil {
+		fields = append(fields, server.FieldMaxPlayers)
+	}
+	if m.online != nil {
+		fields = append(fields, server.FieldOnline)
+	}
+	if m.last_heartbeat != nil {
+		fields = append(fields, server.FieldLastHeartbeat)
+	}
+	if m.created_at != nil {
+		fields = append(fields, server.FieldCreatedAt)
 	}
 	return fields
 }
@@ -371,16 +5179,24 @@ func (m *CharacterMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CharacterMutation) Field(name string) (ent.Value, bool) {
+func (m *ServerMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case character.FieldSteamid:
-		return m.Steamid()
-	case character.FieldSlot:
-		return m.Slot()
-	case character.FieldSize:
-		return m.Size()
-	case character.FieldData:
-		return m.Data()
+	case server.FieldName:
+		return m.Name()
+	case server.FieldAddress:
+		return m.Address()
+	case server.FieldMap:
+		return m.Map()
+	case server.FieldPlayers:
+		return m.Players()
+	case server.FieldMaxPlayers:
+		return m.MaxPlayers()
+	case server.FieldOnline:
+		return m.Online()
+	case server.FieldLastHeartbeat:
+		return m.LastHeartbeat()
+	case server.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -388,66 +5204,102 @@ func (m *CharacterMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CharacterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ServerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case character.FieldSteamid:
-		return m.OldSteamid(ctx)
-	case character.FieldSlot:
-		return m.OldSlot(ctx)
-	case character.FieldSize:
-		return m.OldSize(ctx)
-	case character.FieldData:
-		return m.OldData(ctx)
+	case server.FieldName:
+		return m.OldName(ctx)
+	case server.FieldAddress:
+		return m.OldAddress(ctx)
+	case server.FieldMap:
+		return m.OldMap(ctx)
+	case server.FieldPlayers:
+		return m.OldPlayers(ctx)
+	case server.FieldMaxPlayers:
+		return m.OldMaxPlayers(ctx)
+	case server.FieldOnline:
+		return m.OldOnline(ctx)
+	case server.FieldLastHeartbeat:
+		return m.OldLastHeartbeat(ctx)
+	case server.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Character field %s", name)
+	return nil, fmt.Errorf("unknown Server field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CharacterMutation) SetField(name string, value ent.Value) error {
+func (m *ServerMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case character.FieldSteamid:
+	case server.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSteamid(v)
+		m.SetName(v)
 		return nil
-	case character.FieldSlot:
+	case server.FieldAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	case server.FieldMap:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMap(v)
+		return nil
+	case server.FieldPlayers:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSlot(v)
+		m.SetPlayers(v)
 		return nil
-	case character.FieldSize:
+	case server.FieldMaxPlayers:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSize(v)
+		m.SetMaxPlayers(v)
 		return nil
-	case character.FieldData:
-		v, ok := value.(string)
+	case server.FieldOnline:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetData(v)
+		m.SetOnline(v)
+		return nil
+	case server.FieldLastHeartbeat:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastHeartbeat(v)
+		return nil
+	case server.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Character field %s", name)
+	return fmt.Errorf("unknown Server field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CharacterMutation) AddedFields() []string {
+func (m *ServerMutation) AddedFields() []string {
 	var fields []string
-	if m.addslot != nil {
-		fields = append(fields, character.FieldSlot)
+	if m.addplayers != nil {
+		fields = append(fields, server.FieldPlayers)
 	}
-	if m.addsize != nil {
-		fields = append(fields, character.FieldSize)
+	if m.addmax_players != nil {
+		fields = append(fields, server.FieldMaxPlayers)
 	}
 	return fields
 }
@@ -455,12 +5307,12 @@ func (m *CharacterMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CharacterMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ServerMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case character.FieldSlot:
-		return m.AddedSlot()
-	case character.FieldSize:
-		return m.AddedSize()
+	case server.FieldPlayers:
+		return m.AddedPlayers()
+	case server.FieldMaxPlayers:
+		return m.AddedMaxPlayers()
 	}
 	return nil, false
 }
@@ -468,109 +5320,121 @@ func (m *CharacterMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CharacterMutation) AddField(name string, value ent.Value) error {
+func (m *ServerMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case character.FieldSlot:
+	case server.FieldPlayers:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSlot(v)
+		m.AddPlayers(v)
 		return nil
-	case character.FieldSize:
+	case server.FieldMaxPlayers:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSize(v)
+		m.AddMaxPlayers(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Character numeric field %s", name)
+	return fmt.Errorf("unknown Server numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CharacterMutation) ClearedFields() []string {
+func (m *ServerMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CharacterMutation) FieldCleared(name string) bool {
+func (m *ServerMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CharacterMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Character nullable field %s", name)
+func (m *ServerMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Server nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CharacterMutation) ResetField(name string) error {
+func (m *ServerMutation) ResetField(name string) error {
 	switch name {
-	case character.FieldSteamid:
-		m.ResetSteamid()
+	case server.FieldName:
+		m.ResetName()
 		return nil
-	case character.FieldSlot:
-		m.ResetSlot()
+	case server.FieldAddress:
+		m.ResetAddress()
 		return nil
-	case character.FieldSize:
-		m.ResetSize()
+	case server.FieldMap:
+		m.ResetMap()
 		return nil
-	case character.FieldData:
-		m.ResetData()
+	case server.FieldPlayers:
+		m.ResetPlayers()
+		return nil
+	case server.FieldMaxPlayers:
+		m.ResetMaxPlayers()
+		return nil
+	case server.FieldOnline:
+		m.ResetOnline()
+		return nil
+	case server.FieldLastHeartbeat:
+		m.ResetLastHeartbeat()
+		return nil
+	case server.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Character field %s", name)
+	return fmt.Errorf("unknown Server field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CharacterMutation) AddedEdges() []string {
+func (m *ServerMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CharacterMutation) AddedIDs(name string) []ent.Value {
+func (m *ServerMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CharacterMutation) RemovedEdges() []string {
+func (m *ServerMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CharacterMutation) RemovedIDs(name string) []ent.Value {
+func (m *ServerMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CharacterMutation) ClearedEdges() []string {
+func (m *ServerMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CharacterMutation) EdgeCleared(name string) bool {
+func (m *ServerMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CharacterMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Character unique edge %s", name)
+func (m *ServerMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Server unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CharacterMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Character edge %s", name)
+func (m *ServerMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Server edge %s", name)
 }
@@ -6,5 +6,23 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Anomaly is the predicate function for anomaly builders.
+type Anomaly func(*sql.Selector)
+
+// AuditEvent is the predicate function for auditevent builders.
+type AuditEvent func(*sql.Selector)
+
+// Ban is the predicate function for ban builders.
+type Ban func(*sql.Selector)
+
 // Character is the predicate function for character builders.
 type Character func(*sql.Selector)
+
+// CharacterLock is the predicate function for characterlock builders.
+type CharacterLock func(*sql.Selector)
+
+// CharacterVersion is the predicate function for characterversion builders.
+type CharacterVersion func(*sql.Selector)
+
+// Server is the predicate function for server builders.
+type Server func(*sql.Selector)
@@ -3,15 +3,101 @@
 package ent
 
 import (
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/anomaly"
+	"github.com/msrevive/nexus2/ent/auditevent"
+	"github.com/msrevive/nexus2/ent/ban"
 	"github.com/msrevive/nexus2/ent/character"
+	"github.com/msrevive/nexus2/ent/characterlock"
+	"github.com/msrevive/nexus2/ent/characterversion"
 	"github.com/msrevive/nexus2/ent/schema"
+	"github.com/msrevive/nexus2/ent/server"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	anomalyFields := schema.Anomaly{}.Fields()
+	_ = anomalyFields
+	// anomalyDescServer is the schema descriptor for server field.
+	anomalyDescServer := anomalyFields[3].Descriptor()
+	// anomaly.DefaultServer holds the default value on creation for the server field.
+	anomaly.DefaultServer = anomalyDescServer.Default.(string)
+	// anomalyDescStatus is the schema descriptor for status field.
+	anomalyDescStatus := anomalyFields[6].Descriptor()
+	// anomaly.DefaultStatus holds the default value on creation for the status field.
+	anomaly.DefaultStatus = anomalyDescStatus.Default.(string)
+	// anomalyDescSize is the schema descriptor for size field.
+	anomalyDescSize := anomalyFields[8].Descriptor()
+	// anomaly.DefaultSize holds the default value on creation for the size field.
+	anomaly.DefaultSize = anomalyDescSize.Default.(int)
+	// anomalyDescCreatedAt is the schema descriptor for created_at field.
+	anomalyDescCreatedAt := anomalyFields[10].Descriptor()
+	// anomaly.DefaultCreatedAt holds the default value on creation for the created_at field.
+	anomaly.DefaultCreatedAt = anomalyDescCreatedAt.Default.(func() time.Time)
+	// anomalyDescReviewedBy is the schema descriptor for reviewed_by field.
+	anomalyDescReviewedBy := anomalyFields[12].Descriptor()
+	// anomaly.DefaultReviewedBy holds the default value on creation for the reviewed_by field.
+	anomaly.DefaultReviewedBy = anomalyDescReviewedBy.Default.(string)
+	// anomalyDescID is the schema descriptor for id field.
+	anomalyDescID := anomalyFields[0].Descriptor()
+	// anomaly.DefaultID holds the default value on creation for the id field.
+	anomaly.DefaultID = anomalyDescID.Default.(func() uuid.UUID)
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescActor is the schema descriptor for actor field.
+	auditeventDescActor := auditeventFields[1].Descriptor()
+	// auditevent.DefaultActor holds the default value on creation for the actor field.
+	auditevent.DefaultActor = auditeventDescActor.Default.(string)
+	// auditeventDescServer is the schema descriptor for server field.
+	auditeventDescServer := auditeventFields[2].Descriptor()
+	// auditevent.DefaultServer holds the default value on creation for the server field.
+	auditevent.DefaultServer = auditeventDescServer.Default.(string)
+	// auditeventDescAction is the schema descriptor for action field.
+	auditeventDescAction := auditeventFields[3].Descriptor()
+	// auditevent.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditevent.ActionValidator = auditeventDescAction.Validators[0].(func(string) error)
+	// auditeventDescSteamid is the schema descriptor for steamid field.
+	auditeventDescSteamid := auditeventFields[4].Descriptor()
+	// auditevent.DefaultSteamid holds the default value on creation for the steamid field.
+	auditevent.DefaultSteamid = auditeventDescSteamid.Default.(string)
+	// auditeventDescRequestID is the schema descriptor for request_id field.
+	auditeventDescRequestID := auditeventFields[8].Descriptor()
+	// auditevent.DefaultRequestID holds the default value on creation for the request_id field.
+	auditevent.DefaultRequestID = auditeventDescRequestID.Default.(string)
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventFields[9].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	// auditeventDescID is the schema descriptor for id field.
+	auditeventDescID := auditeventFields[0].Descriptor()
+	// auditevent.DefaultID holds the default value on creation for the id field.
+	auditevent.DefaultID = auditeventDescID.Default.(func() uuid.UUID)
+	banFields := schema.Ban{}.Fields()
+	_ = banFields
+	// banDescSteamid is the schema descriptor for steamid field.
+	banDescSteamid := banFields[1].Descriptor()
+	// ban.SteamidValidator is a validator for the "steamid" field. It is called by the builders before save.
+	ban.SteamidValidator = banDescSteamid.Validators[0].(func(string) error)
+	// banDescReason is the schema descriptor for reason field.
+	banDescReason := banFields[2].Descriptor()
+	// ban.DefaultReason holds the default value on creation for the reason field.
+	ban.DefaultReason = banDescReason.Default.(string)
+	// banDescIssuedBy is the schema descriptor for issued_by field.
+	banDescIssuedBy := banFields[3].Descriptor()
+	// ban.DefaultIssuedBy holds the default value on creation for the issued_by field.
+	ban.DefaultIssuedBy = banDescIssuedBy.Default.(string)
+	// banDescCreatedAt is the schema descriptor for created_at field.
+	banDescCreatedAt := banFields[4].Descriptor()
+	// ban.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ban.DefaultCreatedAt = banDescCreatedAt.Default.(func() time.Time)
+	// banDescID is the schema descriptor for id field.
+	banDescID := banFields[0].Descriptor()
+	// ban.DefaultID holds the default value on creation for the id field.
+	ban.DefaultID = banDescID.Default.(func() uuid.UUID)
 	characterFields := schema.Character{}.Fields()
 	_ = characterFields
 	// characterDescSlot is the schema descriptor for slot field.
@@ -24,8 +110,96 @@ func init() {
 	characterDescSize := characterFields[3].Descriptor()
 	// character.DefaultSize holds the default value on creation for the size field.
 	character.DefaultSize = characterDescSize.Default.(int)
+	// characterDescCreatedAt is the schema descriptor for created_at field.
+	characterDescCreatedAt := characterFields[7].Descriptor()
+	// character.DefaultCreatedAt holds the default value on creation for the created_at field.
+	character.DefaultCreatedAt = characterDescCreatedAt.Default.(func() time.Time)
+	// characterDescUpdatedAt is the schema descriptor for updated_at field.
+	characterDescUpdatedAt := characterFields[8].Descriptor()
+	// character.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	character.DefaultUpdatedAt = characterDescUpdatedAt.Default.(func() time.Time)
+	// character.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	character.UpdateDefaultUpdatedAt = characterDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// characterDescVersion is the schema descriptor for version field.
+	characterDescVersion := characterFields[9].Descriptor()
+	// character.DefaultVersion holds the default value on creation for the version field.
+	character.DefaultVersion = characterDescVersion.Default.(func() int)
 	// characterDescID is the schema descriptor for id field.
 	characterDescID := characterFields[0].Descriptor()
 	// character.DefaultID holds the default value on creation for the id field.
 	character.DefaultID = characterDescID.Default.(func() uuid.UUID)
+	characterlockFields := schema.CharacterLock{}.Fields()
+	_ = characterlockFields
+	// characterlockDescServer is the schema descriptor for server field.
+	characterlockDescServer := characterlockFields[2].Descriptor()
+	// characterlock.ServerValidator is a validator for the "server" field. It is called by the builders before save.
+	characterlock.ServerValidator = characterlockDescServer.Validators[0].(func(string) error)
+	// characterlockDescCreatedAt is the schema descriptor for created_at field.
+	characterlockDescCreatedAt := characterlockFields[4].Descriptor()
+	// characterlock.DefaultCreatedAt holds the default value on creation for the created_at field.
+	characterlock.DefaultCreatedAt = characterlockDescCreatedAt.Default.(func() time.Time)
+	// characterlockDescID is the schema descriptor for id field.
+	characterlockDescID := characterlockFields[0].Descriptor()
+	// characterlock.DefaultID holds the default value on creation for the id field.
+	characterlock.DefaultID = characterlockDescID.Default.(func() uuid.UUID)
+	characterversionFields := schema.CharacterVersion{}.Fields()
+	_ = characterversionFields
+	// characterversionDescVersion is the schema descriptor for version field.
+	characterversionDescVersion := characterversionFields[2].Descriptor()
+	// characterversion.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	characterversion.VersionValidator = characterversionDescVersion.Validators[0].(func(int) error)
+	// characterversionDescSize is the schema descriptor for size field.
+	characterversionDescSize := characterversionFields[3].Descriptor()
+	// characterversion.DefaultSize holds the default value on creation for the size field.
+	characterversion.DefaultSize = characterversionDescSize.Default.(int)
+	// characterversionDescCreatedAt is the schema descriptor for created_at field.
+	characterversionDescCreatedAt := characterversionFields[5].Descriptor()
+	// characterversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	characterversion.DefaultCreatedAt = characterversionDescCreatedAt.Default.(func() time.Time)
+	// characterversionDescID is the schema descriptor for id field.
+	characterversionDescID := characterversionFields[0].Descriptor()
+	// characterversion.DefaultID holds the default value on creation for the id field.
+	characterversion.DefaultID = characterversionDescID.Default.(func() uuid.UUID)
+	serverFields := schema.Server{}.Fields()
+	_ = serverFields
+	// serverDescName is the schema descriptor for name field.
+	serverDescName := serverFields[1].Descriptor()
+	// server.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	server.NameValidator = serverDescName.Validators[0].(func(string) error)
+	// serverDescAddress is the schema descriptor for address field.
+	serverDescAddress := serverFields[2].Descriptor()
+	// server.DefaultAddress holds the default value on creation for the address field.
+	server.DefaultAddress = serverDescAddress.Default.(string)
+	// serverDescMap is the schema descriptor for map field.
+	serverDescMap := serverFields[3].Descriptor()
+	// server.DefaultMap holds the default value on creation for the map field.
+	server.DefaultMap = serverDescMap.Default.(string)
+	// serverDescPlayers is the schema descriptor for players field.
+	serverDescPlayers := serverFields[4].Descriptor()
+	// server.DefaultPlayers holds the default value on creation for the players field.
+	server.DefaultPlayers = serverDescPlayers.Default.(int)
+	// server.PlayersValidator is a validator for the "players" field. It is called by the builders before save.
+	server.PlayersValidator = serverDescPlayers.Validators[0].(func(int) error)
+	// serverDescMaxPlayers is the schema descriptor for max_players field.
+	serverDescMaxPlayers := serverFields[5].Descriptor()
+	// server.DefaultMaxPlayers holds the default value on creation for the max_players field.
+	server.DefaultMaxPlayers = serverDescMaxPlayers.Default.(int)
+	// server.MaxPlayersValidator is a validator for the "max_players" field. It is called by the builders before save.
+	server.MaxPlayersValidator = serverDescMaxPlayers.Validators[0].(func(int) error)
+	// serverDescOnline is the schema descriptor for online field.
+	serverDescOnline := serverFields[6].Descriptor()
+	// server.DefaultOnline holds the default value on creation for the online field.
+	server.DefaultOnline = serverDescOnline.Default.(bool)
+	// serverDescLastHeartbeat is the schema descriptor for last_heartbeat field.
+	serverDescLastHeartbeat := serverFields[7].Descriptor()
+	// server.DefaultLastHeartbeat holds the default value on creation for the last_heartbeat field.
+	server.DefaultLastHeartbeat = serverDescLastHeartbeat.Default.(func() time.Time)
+	// serverDescCreatedAt is the schema descriptor for created_at field.
+	serverDescCreatedAt := serverFields[8].Descriptor()
+	// server.DefaultCreatedAt holds the default value on creation for the created_at field.
+	server.DefaultCreatedAt = serverDescCreatedAt.Default.(func() time.Time)
+	// serverDescID is the schema descriptor for id field.
+	serverDescID := serverFields[0].Descriptor()
+	// server.DefaultID holds the default value on creation for the id field.
+	server.DefaultID = serverDescID.Default.(func() uuid.UUID)
 }
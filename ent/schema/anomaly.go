@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Anomaly holds the schema definition for the Anomaly entity.
+// Each row is a suspicious save caught by the anomaly rules, kept for admins to review.
+type Anomaly struct {
+	ent.Schema
+}
+
+// Fields of the Anomaly.
+func (Anomaly) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Immutable().
+			Default(uuid.New),
+		field.UUID("character_id", uuid.UUID{}).
+			Immutable(),
+		field.String("steamid").
+			Immutable(),
+		field.String("server").
+			Default("").
+			Immutable(),
+		field.String("action").
+			Immutable(),
+		field.Strings("findings").
+			Immutable(),
+		field.String("status").
+			Default("open"),
+		field.Int("version").
+			Immutable().
+			StructTag(`json:"version"`),
+		field.Int("size").
+			Default(0).
+			Immutable(),
+		// only kept for quarantined saves, so they can be applied if an admin approves them.
+		field.String("data").
+			Optional().
+			Immutable().
+			SchemaType(map[string]string{
+				dialect.SQLite:   "text",
+				dialect.Postgres: "text",
+				dialect.MySQL:    "longtext",
+			}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"reviewed_at,omitempty"`),
+		field.String("reviewed_by").
+			Default(""),
+	}
+}
+
+// Edges of the Anomaly.
+func (Anomaly) Edges() []ent.Edge {
+	return nil
+}
+
+func (Anomaly) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status").
+			StorageKey("anomaly_status"),
+		index.Fields("steamid").
+			StorageKey("anomaly_steamid"),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity.
+type AuditEvent struct {
+	ent.Schema
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Immutable().
+			Default(uuid.New),
+		field.String("actor").
+			Default("").
+			Immutable(),
+		field.String("server").
+			Default("").
+			Immutable().
+			StructTag(`json:"server,omitempty"`),
+		field.String("action").
+			NotEmpty().
+			Immutable(),
+		field.String("steamid").
+			Default("").
+			Immutable().
+			StructTag(`json:"steamid,omitempty"`),
+		field.UUID("character_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"character_id,omitempty"`),
+		field.Int("size_before").
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"size_before,omitempty"`),
+		field.Int("size_after").
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"size_after,omitempty"`),
+		field.String("request_id").
+			Default("").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the AuditEvent.
+func (AuditEvent) Edges() []ent.Edge {
+	return nil
+}
+
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("steamid").
+			StorageKey("auditevent_steamid"),
+		index.Fields("actor").
+			StorageKey("auditevent_actor"),
+		index.Fields("created_at").
+			StorageKey("auditevent_created_at"),
+	}
+}
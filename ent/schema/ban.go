@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Ban holds the schema definition for the Ban entity.
+type Ban struct {
+	ent.Schema
+}
+
+// Fields of the Ban.
+func (Ban) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Immutable().
+			Default(uuid.New),
+		field.String("steamid").
+			NotEmpty(),
+		field.String("reason").
+			Default(""),
+		field.String("issued_by").
+			Default(""),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"expires_at,omitempty"`),
+	}
+}
+
+// Edges of the Ban.
+func (Ban) Edges() []ent.Edge {
+	return nil
+}
+
+func (Ban) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("steamid"),
+	}
+}
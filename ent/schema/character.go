@@ -1,12 +1,14 @@
 package schema
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
-	//"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/edge"
 )
 
 // Character holds the schema definition for the Character entity.
@@ -29,20 +31,53 @@ func (Character) Fields() []ent.Field {
 			Default(0),
 		field.String("data").
 			SchemaType(map[string]string{
-				dialect.SQLite: "text",
+				dialect.SQLite:   "text",
+				dialect.Postgres: "text",
+				dialect.MySQL:    "longtext",
 			}),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"deleted_at,omitempty"`),
+		// True while the character holds its slot and null once it's deleted. Nulls never clash in a
+		// unique index, so deleted characters can share a slot with the live one until they're purged.
+		field.Bool("live").
+			Optional().
+			Nillable().
+			StructTag(`json:"-"`),
+		field.Time("created_at").
+			Optional().
+			Nillable().
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Optional().
+			Nillable().
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		// Bumped on every write so clients can tell if a character changed since they loaded it.
+		// Characters saved before it was added have none and read as 0.
+		field.Int("version").
+			Optional().
+			DefaultFunc(func() int { return 1 }).
+			StructTag(`json:"version"`),
 	}
 }
 
 // Edges of the Character.
 func (Character) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("versions", CharacterVersion.Type),
+	}
 }
 
 func (Character) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("id").
 			Unique(),
-		index.Fields("steamid", "slot"),
+		// Only live characters count, see live. A new name so the old index on steamid and slot is swapped out.
+		index.Fields("steamid", "slot", "live").
+			Unique().
+			StorageKey("character_live_slot"),
 	}
 }
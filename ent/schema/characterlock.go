@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CharacterLock holds the schema definition for the CharacterLock entity.
+type CharacterLock struct {
+	ent.Schema
+}
+
+// Fields of the CharacterLock.
+func (CharacterLock) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Immutable().
+			Default(uuid.New),
+		field.UUID("character_id", uuid.UUID{}),
+		field.String("server").
+			NotEmpty(),
+		field.Time("expires_at"),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the CharacterLock.
+func (CharacterLock) Edges() []ent.Edge {
+	return nil
+}
+
+func (CharacterLock) Indexes() []ent.Index {
+	return []ent.Index{
+		// SQLite index names are shared across tables, so this can't be left as "character_id".
+		index.Fields("character_id").
+			Unique().
+			StorageKey("characterlock_character_id"),
+	}
+}
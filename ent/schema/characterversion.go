@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CharacterVersion holds the schema definition for the CharacterVersion entity.
+// Each row is a snapshot of a character's data before it was overwritten.
+type CharacterVersion struct {
+	ent.Schema
+}
+
+// Fields of the CharacterVersion.
+func (CharacterVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Immutable().
+			Default(uuid.New),
+		field.UUID("character_id", uuid.UUID{}),
+		field.Int("version").
+			Min(1).
+			Immutable(),
+		field.Int("size").
+			Default(0).
+			Immutable(),
+		field.String("data").
+			Immutable().
+			SchemaType(map[string]string{
+				dialect.SQLite:   "text",
+				dialect.Postgres: "text",
+				dialect.MySQL:    "longtext",
+			}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the CharacterVersion.
+func (CharacterVersion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("character", Character.Type).
+			Ref("versions").
+			Field("character_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (CharacterVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("character_id", "version").
+			Unique(),
+	}
+}
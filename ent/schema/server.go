@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Server holds the schema definition for the Server entity.
+type Server struct {
+	ent.Schema
+}
+
+// Fields of the Server.
+func (Server) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Immutable().
+			Default(uuid.New),
+		field.String("name").
+			NotEmpty(),
+		field.String("address").
+			Default(""),
+		field.String("map").
+			Default(""),
+		field.Int("players").
+			Min(0).
+			Default(0).
+			StructTag(`json:"players"`),
+		field.Int("max_players").
+			Min(0).
+			Default(0).
+			StructTag(`json:"max_players"`),
+		field.Bool("online").
+			Default(true).
+			StructTag(`json:"online"`),
+		field.Time("last_heartbeat").
+			Default(time.Now),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Server.
+func (Server) Edges() []ent.Edge {
+	return nil
+}
+
+func (Server) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Unique().
+			StorageKey("server_name"),
+	}
+}
@@ -0,0 +1,177 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/server"
+)
+
+// Server is the model entity for the Server schema.
+type Server struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Address holds the value of the "address" field.
+	Address string `json:"address,omitempty"`
+	// Map holds the value of the "map" field.
+	Map string `json:"map,omitempty"`
+	// Players holds the value of the "players" field.
+	Players int `json:"players"`
+	// MaxPlayers holds the value of the "max_players" field.
+	MaxPlayers int `json:"max_players"`
+	// Online holds the value of the "online" field.
+	Online bool `json:"online"`
+	// LastHeartbeat holds the value of the "last_heartbeat" field.
+	LastHeartbeat time.Time `json:"last_heartbeat,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Server) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case server.FieldOnline:
+			values[i] = new(sql.NullBool)
+		case server.FieldPlayers, server.FieldMaxPlayers:
+			values[i] = new(sql.NullInt64)
+		case server.FieldName, server.FieldAddress, server.FieldMap:
+			values[i] = new(sql.NullString)
+		case server.FieldLastHeartbeat, server.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case server.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Server", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Server fields.
+func (s *Server) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case server.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				s.ID = *value
+			}
+		case server.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				s.Name = value.String
+			}
+		case server.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				s.Address = value.String
+			}
+		case server.FieldMap:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field map", values[i])
+			} else if value.Valid {
+				s.Map = value.String
+			}
+		case server.FieldPlayers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field players", values[i])
+			} else if value.Valid {
+				s.Players = int(value.Int64)
+			}
+		case server.FieldMaxPlayers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_players", values[i])
+			} else if value.Valid {
+				s.MaxPlayers = int(value.Int64)
+			}
+		case server.FieldOnline:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field online", values[i])
+			} else if value.Valid {
+				s.Online = value.Bool
+			}
+		case server.FieldLastHeartbeat:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_heartbeat", values[i])
+			} else if value.Valid {
+				s.LastHeartbeat = value.Time
+			}
+		case server.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Server.
+// Note that you need to call Server.Unwrap() before calling this method if this Server
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Server) Update() *ServerUpdateOne {
+	return (&ServerClient{config: s.config}).UpdateOne(s)
+}
+
+// Unwrap unwraps the Server entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Server) Unwrap() *Server {
+	tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Server is not a transactional entity")
+	}
+	s.config.driver = tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Server) String() string {
+	var builder strings.Builder
+	builder.WriteString("Server(")
+	builder.WriteString(fmt.Sprintf("id=%v", s.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(s.Name)
+	builder.WriteString(", address=")
+	builder.WriteString(s.Address)
+	builder.WriteString(", map=")
+	builder.WriteString(s.Map)
+	builder.WriteString(", players=")
+	builder.WriteString(fmt.Sprintf("%v", s.Players))
+	builder.WriteString(", max_players=")
+	builder.WriteString(fmt.Sprintf("%v", s.MaxPlayers))
+	builder.WriteString(", online=")
+	builder.WriteString(fmt.Sprintf("%v", s.Online))
+	builder.WriteString(", last_heartbeat=")
+	builder.WriteString(s.LastHeartbeat.Format(time.ANSIC))
+	builder.WriteString(", created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Servers is a parsable slice of Server.
+type Servers []*Server
+
+func (s Servers) config(cfg config) {
+	for _i := range s {
+		s[_i].config = cfg
+	}
+}
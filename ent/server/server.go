@@ -0,0 +1,82 @@
+// Code generated by entc, DO NOT EDIT.
+
+package server
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the server type in the database.
+	Label = "server"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldAddress holds the string denoting the address field in the database.
+	FieldAddress = "address"
+	// FieldMap holds the string denoting the map field in the database.
+	FieldMap = "map"
+	// FieldPlayers holds the string denoting the players field in the database.
+	FieldPlayers = "players"
+	// FieldMaxPlayers holds the string denoting the max_players field in the database.
+	FieldMaxPlayers = "max_players"
+	// FieldOnline holds the string denoting the online field in the database.
+	FieldOnline = "online"
+	// FieldLastHeartbeat holds the string denoting the last_heartbeat field in the database.
+	FieldLastHeartbeat = "last_heartbeat"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the server in the database.
+	Table = "servers"
+)
+
+// Columns holds all SQL columns for server fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldAddress,
+	FieldMap,
+	FieldPlayers,
+	FieldMaxPlayers,
+	FieldOnline,
+	FieldLastHeartbeat,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultAddress holds the default value on creation for the "address" field.
+	DefaultAddress string
+	// DefaultMap holds the default value on creation for the "map" field.
+	DefaultMap string
+	// DefaultPlayers holds the default value on creation for the "players" field.
+	DefaultPlayers int
+	// PlayersValidator is a validator for the "players" field. It is called by the builders before save.
+	PlayersValidator func(int) error
+	// DefaultMaxPlayers holds the default value on creation for the "max_players" field.
+	DefaultMaxPlayers int
+	// MaxPlayersValidator is a validator for the "max_players" field. It is called by the builders before save.
+	MaxPlayersValidator func(int) error
+	// DefaultOnline holds the default value on creation for the "online" field.
+	DefaultOnline bool
+	// DefaultLastHeartbeat holds the default value on creation for the "last_heartbeat" field.
+	DefaultLastHeartbeat func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
@@ -0,0 +1,833 @@
+// Code generated by entc, DO NOT EDIT.
+
+package server
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAddress), v))
+	})
+}
+
+// Map applies equality check predicate on the "map" field. It's identical to MapEQ.
+func Map(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMap), v))
+	})
+}
+
+// Players applies equality check predicate on the "players" field. It's identical to PlayersEQ.
+func Players(v int) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPlayers), v))
+	})
+}
+
+// MaxPlayers applies equality check predicate on the "max_players" field. It's identical to MaxPlayersEQ.
+func MaxPlayers(v int) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMaxPlayers), v))
+	})
+}
+
+// Online applies equality check predicate on the "online" field. It's identical to OnlineEQ.
+func Online(v bool) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOnline), v))
+	})
+}
+
+// LastHeartbeat applies equality check predicate on the "last_heartbeat" field. It's identical to LastHeartbeatEQ.
+func LastHeartbeat(v time.Time) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastHeartbeat), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Server {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Server(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Server {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Server(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAddress), v))
+	})
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAddress), v))
+	})
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.Server {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Server(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAddress), v...))
+	})
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.Server {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Server(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAddress), v...))
+	})
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAddress), v))
+	})
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAddress), v))
+	})
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAddress), v))
+	})
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAddress), v))
+	})
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAddress), v))
+	})
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAddress), v))
+	})
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAddress), v))
+	})
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAddress), v))
+	})
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAddress), v))
+	})
+}
+
+// MapEQ applies the EQ predicate on the "map" field.
+func MapEQ(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMap), v))
+	})
+}
+
+// MapNEQ applies the NEQ predicate on the "map" field.
+func MapNEQ(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMap), v))
+	})
+}
+
+// MapIn applies the In predicate on the "map" field.
+func MapIn(vs ...string) predicate.Server {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Server(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMap), v...))
+	})
+}
+
+// MapNotIn applies the NotIn predicate on the "map" field.
+func MapNotIn(vs ...string) predicate.Server {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Server(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMap), v...))
+	})
+}
+
+// MapGT applies the GT predicate on the "map" field.
+func MapGT(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMap), v))
+	})
+}
+
+// MapGTE applies the GTE predicate on the "map" field.
+func MapGTE(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMap), v))
+	})
+}
+
+// MapLT applies the LT predicate on the "map" field.
+func MapLT(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMap), v))
+	})
+}
+
+// MapLTE applies the LTE predicate on the "map" field.
+func MapLTE(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMap), v))
+	})
+}
+
+// MapContains applies the Contains predicate on the "map" field.
+func MapContains(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldMap), v))
+	})
+}
+
+// MapHasPrefix applies the HasPrefix predicate on the "map" field.
+func MapHasPrefix(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldMap), v))
+	})
+}
+
+// MapHasSuffix applies the HasSuffix predicate on the "map" field.
+func MapHasSuffix(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldMap), v))
+	})
+}
+
+// MapEqualFold applies the EqualFold predicate on the "map" field.
+func MapEqualFold(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldMap), v))
+	})
+}
+
+// MapContainsFold applies the ContainsFold predicate on the "map" field.
+func MapContainsFold(v string) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldMap), v))
+	})
+}
+
+// PlayersEQ applies the EQ predicate on the "players" field.
+func PlayersEQ(v int) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPlayers), v))
+	})
+}
+
+// PlayersNEQ applies the NEQ predicate on the "players" field.
+func PlayersNEQ(v int) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPlayers), v))
+	})
+}
+
+// PlayersIn applies the In predicate on the "players" field.
+func PlayersIn(vs ...int) predicate.Server {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Server(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPlayers), v...))
+	})
+}
+
+// PlayersNotIn applies the NotIn predicate on the "players" field.
+func PlayersNotIn(vs ...int) predicate.Server {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Server(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPlayers), v...))
+	})
+}
+
+// PlayersGT applies the GT predicate on the "players" field.
+func PlayersGT(v int) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPlayers), v))
+	})
+}
+
+// PlayersGTE applies the GTE predicate on the "players" field.
+func PlayersGTE(v int) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPlayers), v))
+	})
+}
+
+// PlayersLT applies the LT predicate on the "players" field.
+func PlayersLT(v int) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPlayers), v))
+	})
+}
+
+// PlayersLTE applies the LTE predicate on the "players" field.
+func PlayersLTE(v int) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPlayers), v))
+	})
+}
+
+// MaxPlayersEQ applies the EQ predicate on the "max_players" field.
+func MaxPlayersEQ(v int) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMaxPlayers), v))
+	})
+}
+
+// MaxPlayersNEQ applies the NEQ predicate on the "max_players" field.
+func MaxPlayersNEQ(v int) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMaxPlayers), v))
+	})
+}
+
+// MaxPlayersIn applies the In predicate on the "max_players" field.
+func MaxPlayersIn(vs ...int) predicate.Server {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Server(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMaxPlayers), v...))
+	})
+}
+
+// MaxPlayersNotIn applies the NotIn predicate on the "max_players" field.
+func MaxPlayersNotIn(vs ...int) predicate.Server {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Server(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMaxPlayers), v...))
+	})
+}
+
+// MaxPlayersGT applies the GT predicate on the "max_players" field.
+func MaxPlayersGT(v int) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMaxPlayers), v))
+	})
+}
+
+// MaxPlayersGTE applies the GTE predicate on the "max_players" field.
+func MaxPlayersGTE(v int) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMaxPlayers), v))
+	})
+}
+
+// MaxPlayersLT applies the LT predicate on the "max_players" field.
+func MaxPlayersLT(v int) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMaxPlayers), v))
+	})
+}
+
+// MaxPlayersLTE applies the LTE predicate on the "max_players" field.
+func MaxPlayersLTE(v int) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMaxPlayers), v))
+	})
+}
+
+// OnlineEQ applies the EQ predicate on the "online" field.
+func OnlineEQ(v bool) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOnline), v))
+	})
+}
+
+// OnlineNEQ applies the NEQ predicate on the "online" field.
+func OnlineNEQ(v bool) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOnline), v))
+	})
+}
+
+// LastHeartbeatEQ applies the EQ predicate on the "last_heartbeat" field.
+func LastHeartbeatEQ(v time.Time) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastHeartbeat), v))
+	})
+}
+
+// LastHeartbeatNEQ applies the NEQ predicate on the "last_heartbeat" field.
+func LastHeartbeatNEQ(v time.Time) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastHeartbeat), v))
+	})
+}
+
+// LastHeartbeatIn applies the In predicate on the "last_heartbeat" field.
+func LastHeartbeatIn(vs ...time.Time) predicate.Server {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Server(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLastHeartbeat), v...))
+	})
+}
+
+// LastHeartbeatNotIn applies the NotIn predicate on the "last_heartbeat" field.
+func LastHeartbeatNotIn(vs ...time.Time) predicate.Server {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Server(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLastHeartbeat), v...))
+	})
+}
+
+// LastHeartbeatGT applies the GT predicate on the "last_heartbeat" field.
+func LastHeartbeatGT(v time.Time) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastHeartbeat), v))
+	})
+}
+
+// LastHeartbeatGTE applies the GTE predicate on the "last_heartbeat" field.
+func LastHeartbeatGTE(v time.Time) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastHeartbeat), v))
+	})
+}
+
+// LastHeartbeatLT applies the LT predicate on the "last_heartbeat" field.
+func LastHeartbeatLT(v time.Time) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastHeartbeat), v))
+	})
+}
+
+// LastHeartbeatLTE applies the LTE predicate on the "last_heartbeat" field.
+func LastHeartbeatLTE(v time.Time) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastHeartbeat), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Server {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Server(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Server {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Server(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Server) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Server) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Server) predicate.Server {
+	return predicate.Server(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,469 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/msrevive/nexus2/ent/server"
+)
+
+// ServerCreate is the builder for creating a Server entity.
+type ServerCreate struct {
+	config
+	mutation *ServerMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (sc *ServerCreate) SetName(s string) *ServerCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetAddress sets the "address" field.
+func (sc *ServerCreate) SetAddress(s string) *ServerCreate {
+	sc.mutation.SetAddress(s)
+	return sc
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (sc *ServerCreate) SetNillableAddress(s *string) *ServerCreate {
+	if s != nil {
+		sc.SetAddress(*s)
+	}
+	return sc
+}
+
+// SetMap sets the "map" field.
+func (sc *ServerCreate) SetMap(s string) *ServerCreate {
+	sc.mutation.SetMap(s)
+	return sc
+}
+
+// SetNillableMap sets the "map" field if the given value is not nil.
+func (sc *ServerCreate) SetNillableMap(s *string) *ServerCreate {
+	if s != nil {
+		sc.SetMap(*s)
+	}
+	return sc
+}
+
+// SetPlayers sets the "players" field.
+func (sc *ServerCreate) SetPlayers(i int) *ServerCreate {
+	sc.mutation.SetPlayers(i)
+	return sc
+}
+
+// SetNillablePlayers sets the "players" field if the given value is not nil.
+func (sc *ServerCreate) SetNillablePlayers(i *int) *ServerCreate {
+	if i != nil {
+		sc.SetPlayers(*i)
+	}
+	return sc
+}
+
+// SetMaxPlayers sets the "max_players" field.
+func (sc *ServerCreate) SetMaxPlayers(i int) *ServerCreate {
+	sc.mutation.SetMaxPlayers(i)
+	return sc
+}
+
+// SetNillableMaxPlayers sets the "max_players" field if the given value is not nil.
+func (sc *ServerCreate) SetNillableMaxPlayers(i *int) *ServerCreate {
+	if i != nil {
+		sc.SetMaxPlayers(*i)
+	}
+	return sc
+}
+
+// SetOnline sets the "online" field.
+func (sc *ServerCreate) SetOnline(b bool) *ServerCreate {
+	sc.mutation.SetOnline(b)
+	return sc
+}
+
+// SetNillableOnline sets the "online" field if the given value is not nil.
+func (sc *ServerCreate) SetNillableOnline(b *bool) *ServerCreate {
+	if b != nil {
+		sc.SetOnline(*b)
+	}
+	return sc
+}
+
+// SetLastHeartbeat sets the "last_heartbeat" field.
+func (sc *ServerCreate) SetLastHeartbeat(t time.Time) *ServerCreate {
+	sc.mutation.SetLastHeartbeat(t)
+	return sc
+}
+
+// SetNillableLastHeartbeat sets the "last_heartbeat" field if the given value is not nil.
+func (sc *ServerCreate) SetNillableLastHeartbeat(t *time.Time) *ServerCreate {
+	if t != nil {
+		sc.SetLastHeartbeat(*t)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *ServerCreate) SetCreatedAt(t time.Time) *ServerCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *ServerCreate) SetNillableCreatedAt(t *time.Time) *ServerCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *ServerCreate) SetID(u uuid.UUID) *ServerCreate {
+	sc.mutation.SetID(u)
+	return sc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sc *ServerCreate) SetNillableID(u *uuid.UUID) *ServerCreate {
+	if u != nil {
+		sc.SetID(*u)
+	}
+	return sc
+}
+
+// Mutation returns the ServerMutation object of the builder.
+func (sc *ServerCreate) Mutation() *ServerMutation {
+	return sc.mutation
+}
+
+// Save creates the Server in the database.
+func (sc *ServerCreate) Save(ctx context.Context) (*Server, error) {
+	var (
+		err  error
+		node *Server
+	)
+	sc.defaults()
+	if len(sc.hooks) == 0 {
+		if err = sc.check(); err != nil {
+			return nil, err
+		}
+		node, err = sc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ServerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sc.check(); err != nil {
+				return nil, err
+			}
+			sc.mutation = mutation
+			if node, err = sc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sc.hooks) - 1; i >= 0; i-- {
+			if sc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *ServerCreate) SaveX(ctx context.Context) *Server {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *ServerCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *ServerCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *ServerCreate) defaults() {
+	if _, ok := sc.mutation.Address(); !ok {
+		v := server.DefaultAddress
+		sc.mutation.SetAddress(v)
+	}
+	if _, ok := sc.mutation.Map(); !ok {
+		v := server.DefaultMap
+		sc.mutation.SetMap(v)
+	}
+	if _, ok := sc.mutation.Players(); !ok {
+		v := server.DefaultPlayers
+		sc.mutation.SetPlayers(v)
+	}
+	if _, ok := sc.mutation.MaxPlayers(); !ok {
+		v := server.DefaultMaxPlayers
+		sc.mutation.SetMaxPlayers(v)
+	}
+	if _, ok := sc.mutation.Online(); !ok {
+		v := server.DefaultOnline
+		sc.mutation.SetOnline(v)
+	}
+	if _, ok := sc.mutation.LastHeartbeat(); !ok {
+		v := server.DefaultLastHeartbeat()
+		sc.mutation.SetLastHeartbeat(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := server.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.ID(); !ok {
+		v := server.DefaultID()
+		sc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *ServerCreate) check() error {
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Server.name"`)}
+	}
+	if v, ok := sc.mutation.Name(); ok {
+		if err := server.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Server.name": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "Server.address"`)}
+	}
+	if _, ok := sc.mutation.Map(); !ok {
+		return &ValidationError{Name: "map", err: errors.New(`ent: missing required field "Server.map"`)}
+	}
+	if _, ok := sc.mutation.Players(); !ok {
+		return &ValidationError{Name: "players", err: errors.New(`ent: missing required field "Server.players"`)}
+	}
+	if v, ok := sc.mutation.Players(); ok {
+		if err := server.PlayersValidator(v); err != nil {
+			return &ValidationError{Name: "players", err: fmt.Errorf(`ent: validator failed for field "Server.players": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.MaxPlayers(); !ok {
+		return &ValidationError{Name: "max_players", err: errors.New(`ent: missing required field "Server.max_players"`)}
+	}
+	if v, ok := sc.mutation.MaxPlayers(); ok {
+		if err := server.MaxPlayersValidator(v); err != nil {
+			return &ValidationError{Name: "max_players", err: fmt.Errorf(`ent: validator failed for field "Server.max_players": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Online(); !ok {
+		return &ValidationError{Name: "online", err: errors.New(`ent: missing required field "Server.online"`)}
+	}
+	if _, ok := sc.mutation.LastHeartbeat(); !ok {
+		return &ValidationError{Name: "last_heartbeat", err: errors.New(`ent: missing required field "Server.last_heartbeat"`)}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Server.created_at"`)}
+	}
+	return nil
+}
+
+func (sc *ServerCreate) sqlSave(ctx context.Context) (*Server, error) {
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (sc *ServerCreate) createSpec() (*Server, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Server{config: sc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: server.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: server.FieldID,
+			},
+		}
+	)
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: server.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := sc.mutation.Address(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: server.FieldAddress,
+		})
+		_node.Address = value
+	}
+	if value, ok := sc.mutation.Map(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: server.FieldMap,
+		})
+		_node.Map = value
+	}
+	if value, ok := sc.mutation.Players(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: server.FieldPlayers,
+		})
+		_node.Players = value
+	}
+	if value, ok := sc.mutation.MaxPlayers(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: server.FieldMaxPlayers,
+		})
+		_node.MaxPlayers = value
+	}
+	if value, ok := sc.mutation.Online(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: server.FieldOnline,
+		})
+		_node.Online = value
+	}
+	if value, ok := sc.mutation.LastHeartbeat(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: server.FieldLastHeartbeat,
+		})
+		_node.LastHeartbeat = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: server.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ServerCreateBulk is the builder for creating many Server entities in bulk.
+type ServerCreateBulk struct {
+	config
+	builders []*ServerCreate
+}
+
+// Save creates the Server entities in the database.
+func (scb *ServerCreateBulk) Save(ctx context.Context) ([]*Server, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Server, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ServerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *ServerCreateBulk) SaveX(ctx context.Context) []*Server {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *ServerCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *ServerCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
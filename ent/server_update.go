@@ -0,0 +1,626 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/msrevive/nexus2/ent/predicate"
+	"github.com/msrevive/nexus2/ent/server"
+)
+
+// ServerUpdate is the builder for updating Server entities.
+type ServerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ServerMutation
+}
+
+// Where appends a list predicates to the ServerUpdate builder.
+func (su *ServerUpdate) Where(ps ...predicate.Server) *ServerUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetName sets the "name" field.
+func (su *ServerUpdate) SetName(s string) *ServerUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetAddress sets the "address" field.
+func (su *ServerUpdate) SetAddress(s string) *ServerUpdate {
+	su.mutation.SetAddress(s)
+	return su
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (su *ServerUpdate) SetNillableAddress(s *string) *ServerUpdate {
+	if s != nil {
+		su.SetAddress(*s)
+	}
+	return su
+}
+
+// SetMap sets the "map" field.
+func (su *ServerUpdate) SetMap(s string) *ServerUpdate {
+	su.mutation.SetMap(s)
+	return su
+}
+
+// SetNillableMap sets the "map" field if the given value is not nil.
+func (su *ServerUpdate) SetNillableMap(s *string) *ServerUpdate {
+	if s != nil {
+		su.SetMap(*s)
+	}
+	return su
+}
+
+// SetPlayers sets the "players" field.
+func (su *ServerUpdate) SetPlayers(i int) *ServerUpdate {
+	su.mutation.ResetPlayers()
+	su.mutation.SetPlayers(i)
+	return su
+}
+
+// SetNillablePlayers sets the "players" field if the given value is not nil.
+func (su *ServerUpdate) SetNillablePlayers(i *int) *ServerUpdate {
+	if i != nil {
+		su.SetPlayers(*i)
+	}
+	return su
+}
+
+// AddPlayers adds i to the "players" field.
+func (su *ServerUpdate) AddPlayers(i int) *ServerUpdate {
+	su.mutation.AddPlayers(i)
+	return su
+}
+
+// SetMaxPlayers sets the "max_players" field.
+func (su *ServerUpdate) SetMaxPlayers(i int) *ServerUpdate {
+	su.mutation.ResetMaxPlayers()
+	su.mutation.SetMaxPlayers(i)
+	return su
+}
+
+// SetNillableMaxPlayers sets the "max_players" field if the given value is not nil.
+func (su *ServerUpdate) SetNillableMaxPlayers(i *int) *ServerUpdate {
+	if i != nil {
+		su.SetMaxPlayers(*i)
+	}
+	return su
+}
+
+// AddMaxPlayers adds i to the "max_players" field.
+func (su *ServerUpdate) AddMaxPlayers(i int) *ServerUpdate {
+	su.mutation.AddMaxPlayers(i)
+	return su
+}
+
+// SetOnline sets the "online" field.
+func (su *ServerUpdate) SetOnline(b bool) *ServerUpdate {
+	su.mutation.SetOnline(b)
+	return su
+}
+
+// SetNillableOnline sets the "online" field if the given value is not nil.
+func (su *ServerUpdate) SetNillableOnline(b *bool) *ServerUpdate {
+	if b != nil {
+		su.SetOnline(*b)
+	}
+	return su
+}
+
+// SetLastHeartbeat sets the "last_heartbeat" field.
+func (su *ServerUpdate) SetLastHeartbeat(t time.Time) *ServerUpdate {
+	su.mutation.SetLastHeartbeat(t)
+	return su
+}
+
+// SetNillableLastHeartbeat sets the "last_heartbeat" field if the given value is not nil.
+func (su *ServerUpdate) SetNillableLastHeartbeat(t *time.Time) *ServerUpdate {
+	if t != nil {
+		su.SetLastHeartbeat(*t)
+	}
+	return su
+}
+
+// Mutation returns the ServerMutation object of the builder.
+func (su *ServerUpdate) Mutation() *ServerMutation {
+	return su.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *ServerUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(su.hooks) == 0 {
+		if err = su.check(); err != nil {
+			return 0, err
+		}
+		affected, err = su.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ServerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = su.check(); err != nil {
+				return 0, err
+			}
+			su.mutation = mutation
+			affected, err = su.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(su.hooks) - 1; i >= 0; i-- {
+			if su.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = su.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, su.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *ServerUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *ServerUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *ServerUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *ServerUpdate) check() error {
+	if v, ok := su.mutation.Name(); ok {
+		if err := server.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Server.name": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Players(); ok {
+		if err := server.PlayersValidator(v); err != nil {
+			return &ValidationError{Name: "players", err: fmt.Errorf(`ent: validator failed for field "Server.players": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.MaxPlayers(); ok {
+		if err := server.MaxPlayersValidator(v); err != nil {
+			return &ValidationError{Name: "max_players", err: fmt.Errorf(`ent: validator failed for field "Server.max_players": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (su *ServerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   server.Table,
+			Columns: server.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: server.FieldID,
+			},
+		},
+	}
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: server.FieldName,
+		})
+	}
+	if value, ok := su.mutation.Address(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: server.FieldAddress,
+		})
+	}
+	if value, ok := su.mutation.Map(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: server.FieldMap,
+		})
+	}
+	if value, ok := su.mutation.Players(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: server.FieldPlayers,
+		})
+	}
+	if value, ok := su.mutation.AddedPlayers(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: server.FieldPlayers,
+		})
+	}
+	if value, ok := su.mutation.MaxPlayers(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: server.FieldMaxPlayers,
+		})
+	}
+	if value, ok := su.mutation.AddedMaxPlayers(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: server.FieldMaxPlayers,
+		})
+	}
+	if value, ok := su.mutation.Online(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: server.FieldOnline,
+		})
+	}
+	if value, ok := su.mutation.LastHeartbeat(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: server.FieldLastHeartbeat,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{server.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ServerUpdateOne is the builder for updating a single Server entity.
+type ServerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ServerMutation
+}
+
+// SetName sets the "name" field.
+func (suo *ServerUpdateOne) SetName(s string) *ServerUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetAddress sets the "address" field.
+func (suo *ServerUpdateOne) SetAddress(s string) *ServerUpdateOne {
+	suo.mutation.SetAddress(s)
+	return suo
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (suo *ServerUpdateOne) SetNillableAddress(s *string) *ServerUpdateOne {
+	if s != nil {
+		suo.SetAddress(*s)
+	}
+	return suo
+}
+
+// SetMap sets the "map" field.
+func (suo *ServerUpdateOne) SetMap(s string) *ServerUpdateOne {
+	suo.mutation.SetMap(s)
+	return suo
+}
+
+// SetNillableMap sets the "map" field if the given value is not nil.
+func (suo *ServerUpdateOne) SetNillableMap(s *string) *ServerUpdateOne {
+	if s != nil {
+		suo.SetMap(*s)
+	}
+	return suo
+}
+
+// SetPlayers sets the "players" field.
+func (suo *ServerUpdateOne) SetPlayers(i int) *ServerUpdateOne {
+	suo.mutation.ResetPlayers()
+	suo.mutation.SetPlayers(i)
+	return suo
+}
+
+// SetNillablePlayers sets the "players" field if the given value is not nil.
+func (suo *ServerUpdateOne) SetNillablePlayers(i *int) *ServerUpdateOne {
+	if i != nil {
+		suo.SetPlayers(*i)
+	}
+	return suo
+}
+
+// AddPlayers adds i to the "players" field.
+func (suo *ServerUpdateOne) AddPlayers(i int) *ServerUpdateOne {
+	suo.mutation.AddPlayers(i)
+	return suo
+}
+
+// SetMaxPlayers sets the "max_players" field.
+func (suo *ServerUpdateOne) SetMaxPlayers(i int) *ServerUpdateOne {
+	suo.mutation.ResetMaxPlayers()
+	suo.mutation.SetMaxPlayers(i)
+	return suo
+}
+
+// SetNillableMaxPlayers sets the "max_players" field if the given value is not nil.
+func (suo *ServerUpdateOne) SetNillableMaxPlayers(i *int) *ServerUpdateOne {
+	if i != nil {
+		suo.SetMaxPlayers(*i)
+	}
+	return suo
+}
+
+// AddMaxPlayers adds i to the "max_players" field.
+func (suo *ServerUpdateOne) AddMaxPlayers(i int) *ServerUpdateOne {
+	suo.mutation.AddMaxPlayers(i)
+	return suo
+}
+
+// SetOnline sets the "online" field.
+func (suo *ServerUpdateOne) SetOnline(b bool) *ServerUpdateOne {
+	suo.mutation.SetOnline(b)
+	return suo
+}
+
+// SetNillableOnline sets the "online" field if the given value is not nil.
+func (suo *ServerUpdateOne) SetNillableOnline(b *bool) *ServerUpdateOne {
+	if b != nil {
+		suo.SetOnline(*b)
+	}
+	return suo
+}
+
+// SetLastHeartbeat sets the "last_heartbeat" field.
+func (suo *ServerUpdateOne) SetLastHeartbeat(t time.Time) *ServerUpdateOne {
+	suo.mutation.SetLastHeartbeat(t)
+	return suo
+}
+
+// SetNillableLastHeartbeat sets the "last_heartbeat" field if the given value is not nil.
+func (suo *ServerUpdateOne) SetNillableLastHeartbeat(t *time.Time) *ServerUpdateOne {
+	if t != nil {
+		suo.SetLastHeartbeat(*t)
+	}
+	return suo
+}
+
+// Mutation returns the ServerMutation object of the builder.
+func (suo *ServerUpdateOne) Mutation() *ServerMutation {
+	return suo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *ServerUpdateOne) Select(field string, fields ...string) *ServerUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Server entity.
+func (suo *ServerUpdateOne) Save(ctx context.Context) (*Server, error) {
+	var (
+		err  error
+		node *Server
+	)
+	if len(suo.hooks) == 0 {
+		if err = suo.check(); err != nil {
+			return nil, err
+		}
+		node, err = suo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ServerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = suo.check(); err != nil {
+				return nil, err
+			}
+			suo.mutation = mutation
+			node, err = suo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(suo.hooks) - 1; i >= 0; i-- {
+			if suo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = suo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, suo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *ServerUpdateOne) SaveX(ctx context.Context) *Server {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *ServerUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *ServerUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *ServerUpdateOne) check() error {
+	if v, ok := suo.mutation.Name(); ok {
+		if err := server.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Server.name": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Players(); ok {
+		if err := server.PlayersValidator(v); err != nil {
+			return &ValidationError{Name: "players", err: fmt.Errorf(`ent: validator failed for field "Server.players": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.MaxPlayers(); ok {
+		if err := server.MaxPlayersValidator(v); err != nil {
+			return &ValidationError{Name: "max_players", err: fmt.Errorf(`ent: validator failed for field "Server.max_players": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (suo *ServerUpdateOne) sqlSave(ctx context.Context) (_node *Server, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   server.Table,
+			Columns: server.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: server.FieldID,
+			},
+		},
+	}
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Server.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, server.FieldID)
+		for _, f := range fields {
+			if !server.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != server.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: server.FieldName,
+		})
+	}
+	if value, ok := suo.mutation.Address(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: server.FieldAddress,
+		})
+	}
+	if value, ok := suo.mutation.Map(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: server.FieldMap,
+		})
+	}
+	if value, ok := suo.mutation.Players(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: server.FieldPlayers,
+		})
+	}
+	if value, ok := suo.mutation.AddedPlayers(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: server.FieldPlayers,
+		})
+	}
+	if value, ok := suo.mutation.MaxPlayers(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: server.FieldMaxPlayers,
+		})
+	}
+	if value, ok := suo.mutation.AddedMaxPlayers(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: server.FieldMaxPlayers,
+		})
+	}
+	if value, ok := suo.mutation.Online(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: server.FieldOnline,
+		})
+	}
+	if value, ok := suo.mutation.LastHeartbeat(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: server.FieldLastHeartbeat,
+		})
+	}
+	_node = &Server{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{server.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -12,8 +12,20 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Anomaly is the client for interacting with the Anomaly builders.
+	Anomaly *AnomalyClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
+	// Ban is the client for interacting with the Ban builders.
+	Ban *BanClient
 	// Character is the client for interacting with the Character builders.
 	Character *CharacterClient
+	// CharacterLock is the client for interacting with the CharacterLock builders.
+	CharacterLock *CharacterLockClient
+	// CharacterVersion is the client for interacting with the CharacterVersion builders.
+	CharacterVersion *CharacterVersionClient
+	// Server is the client for interacting with the Server builders.
+	Server *ServerClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,7 +161,13 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Anomaly = NewAnomalyClient(tx.config)
+	tx.AuditEvent = NewAuditEventClient(tx.config)
+	tx.Ban = NewBanClient(tx.config)
 	tx.Character = NewCharacterClient(tx.config)
+	tx.CharacterLock = NewCharacterLockClient(tx.config)
+	tx.CharacterVersion = NewCharacterVersionClient(tx.config)
+	tx.Server = NewServerClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -159,7 +177,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Character.QueryXXX(), the query will be executed
+// applies a query, for example: Anomaly.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -1,9 +1,15 @@
 package helper
 
 import (
+  "io"
+  "os"
   "fmt"
+  "path"
   "strconv"
+  "strings"
   "bytes"
+  "path/filepath"
+  "archive/zip"
   "encoding/base64"
 )
 
@@ -32,4 +38,128 @@ func GenerateCharFile(steam64 string, slot int, data string) (*bytes.Reader, str
   reader := bytes.NewReader(d) //we want to create the file in memory only to avoid unneeded io operations
   
   return reader, filename, nil
-}
\ No newline at end of file
+}
+
+//StringToSteam64 turns a STEAM_0:Y:Z id, or the STEAM_0-Y-Z form Steam64ToString makes, back into a steamid64.
+func StringToSteam64(steamStr string) (int64, error) {
+  parts := strings.FieldsFunc(strings.TrimPrefix(steamStr, "STEAM_"), func(r rune) bool {
+    return r == '-' || r == ':'
+  })
+  if len(parts) != 3 {
+    return 0, fmt.Errorf("invalid steamid %q", steamStr)
+  }
+  
+  remainder, err := strconv.ParseInt(parts[1], 10, 64)
+  if err != nil || remainder > 1 {
+    return 0, fmt.Errorf("invalid steamid %q", steamStr)
+  }
+  account, err := strconv.ParseInt(parts[2], 10, 64)
+  if err != nil {
+    return 0, fmt.Errorf("invalid steamid %q", steamStr)
+  }
+  
+  return 76561197960265728 + account*2 + remainder, nil
+}
+
+//ParseCharFilename gets the steamid64 and slot back out of a name made by GenerateCharFile.
+func ParseCharFilename(name string) (string, int, error) {
+  base := filepath.Base(name)
+  if !strings.EqualFold(filepath.Ext(base), ".char") {
+    return "", 0, fmt.Errorf("%s is not a .char file", base)
+  }
+  
+  base = strings.TrimSuffix(base, filepath.Ext(base))
+  i := strings.LastIndex(base, "_")
+  if i == -1 {
+    return "", 0, fmt.Errorf("%s is missing a slot", name)
+  }
+  
+  steamid, err := StringToSteam64(base[:i])
+  if err != nil {
+    return "", 0, err
+  }
+  slot, err := strconv.Atoi(base[i+1:])
+  if err != nil || slot < 0 {
+    return "", 0, fmt.Errorf("%s has an invalid slot", name)
+  }
+  
+  return strconv.FormatInt(steamid, 10), slot, nil
+}
+
+//Limits on what ReadCharZip will unpack, so a small archive can't expand to fill memory.
+const (
+  MaxCharFileSize = 16 << 20
+  MaxCharZipSize = 256 << 20
+)
+
+type CharFile struct {
+  Name string
+  Data []byte
+}
+
+//ReadCharDir reads every .char file in dir, other files are left alone.
+func ReadCharDir(dir string) ([]CharFile, error) {
+  entries, err := os.ReadDir(dir)
+  if err != nil {
+    return nil, err
+  }
+  
+  var files []CharFile
+  for _,e := range entries {
+    if e.IsDir() || !strings.EqualFold(filepath.Ext(e.Name()), ".char") {
+      continue
+    }
+    
+    data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+    if err != nil {
+      return nil, err
+    }
+    files = append(files, CharFile{e.Name(), data})
+  }
+  
+  return files, nil
+}
+
+//ReadCharZip reads every .char file in a zip archive, wherever it is in the archive. Files over
+//MaxCharFileSize, or more than MaxCharZipSize unpacked in all, fail the whole archive.
+func ReadCharZip(r io.ReaderAt, size int64) ([]CharFile, error) {
+  zr, err := zip.NewReader(r, size)
+  if err != nil {
+    return nil, err
+  }
+  
+  var files []CharFile
+  var total int64
+  for _,f := range zr.File {
+    if f.FileInfo().IsDir() || !strings.EqualFold(filepath.Ext(f.Name), ".char") {
+      continue
+    }
+    
+    //the sizes in the archive can lie, so they're only trusted to fail early and the read itself is capped too.
+    limit := int64(MaxCharFileSize)
+    if left := MaxCharZipSize - total; left < limit {
+      limit = left
+    }
+    if f.UncompressedSize64 > uint64(limit) {
+      return nil, fmt.Errorf("%s is too big to import", f.Name)
+    }
+    
+    rc, err := f.Open()
+    if err != nil {
+      return nil, err
+    }
+    data, err := io.ReadAll(io.LimitReader(rc, limit+1))
+    rc.Close()
+    if err != nil {
+      return nil, err
+    }
+    if int64(len(data)) > limit {
+      return nil, fmt.Errorf("%s is too big to import", f.Name)
+    }
+    
+    total += int64(len(data))
+    files = append(files, CharFile{path.Base(f.Name), data})
+  }
+  
+  return files, nil
+}
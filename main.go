@@ -1,7 +1,10 @@
 package main
 
 import(
+  "os"
   "time"
+  "syscall"
+  "os/signal"
   "runtime"
   "strconv"
   "context"
@@ -13,14 +16,20 @@ import(
   "github.com/msrevive/nexus2/system"
   "github.com/msrevive/nexus2/middleware"
   "github.com/msrevive/nexus2/controller"
+  "github.com/msrevive/nexus2/service"
   "github.com/msrevive/nexus2/log"
   "github.com/msrevive/nexus2/ent"
+  "github.com/msrevive/nexus2/metrics"
   
   "github.com/gorilla/mux"
   "golang.org/x/crypto/acme"
   "golang.org/x/crypto/acme/autocert"
-  "entgo.io/ent/dialect/sql/schema"
+  "entgo.io/ent/dialect"
+  entsql "entgo.io/ent/dialect/sql"
+  "github.com/prometheus/client_golang/prometheus/promhttp"
   _ "github.com/mattn/go-sqlite3"
+  _ "github.com/lib/pq"
+  _ "github.com/go-sql-driver/mysql"
 )
 
 func initPrint() {
@@ -63,44 +72,111 @@ func main() {
     runtime.GOMAXPROCS(system.Config.Core.MaxThreads)
   }
   
-  //Load json files.
-  if system.Config.ApiAuth.EnforceIP {
-    log.Log.Printf("Loading IP list from %s", system.Config.ApiAuth.IPListFile)
-    if err := system.LoadIPList(system.Config.ApiAuth.IPListFile); err != nil {
-      log.Log.Warnln("Failed to load IP list.")
-    }
+  //Cancelled on SIGINT/SIGTERM to start shutting down.
+  ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+  defer stop()
+  
+  //Connect database.
+  log.Log.Println("Connecting to database")
+  dbDriver := system.Config.Core.DBDriver
+  switch dbDriver {
+  case "":
+    dbDriver = dialect.SQLite
+  case dialect.SQLite, dialect.Postgres, dialect.MySQL:
+  default:
+    log.Log.Fatalf("unsupported database driver: %s", dbDriver)
   }
   
-  if system.Config.Verify.EnforceMap {
-    log.Log.Printf("Loading Map list from %s", system.Config.Verify.MapListFile)
-    if err := system.LoadMapList(system.Config.Verify.MapListFile); err != nil {
-      log.Log.Warnln("Failed to load Map list.")
+  drv, err := entsql.Open(dbDriver, system.Config.Core.DBString)
+  if err != nil {
+    log.Log.Fatalf("failed to open connection to %s: %v", dbDriver, err)
+  }
+  client := ent.NewClient(ent.Driver(metrics.Driver(drv)))
+  system.Client = client
+  
+  if err := service.New(context.Background()).Migrate(); err != nil {
+		log.Log.Fatalf("failed to create schema resources: %v", err)
+	}
+  
+  //Run a subcommand instead of the server if one was given.
+  if flag.NArg() > 0 {
+    err := runCommand(flag.Args())
+    client.Close()
+    if err != nil {
+      log.Log.Fatalln(err)
     }
+    return
   }
   
-  if system.Config.Verify.EnforceBan {
-    log.Log.Printf("Loading Ban list from %s", system.Config.Verify.BanListFile)
-    if err := system.LoadBanList(system.Config.Verify.BanListFile); err != nil {
-      log.Log.Warnln("Failed to load Ban list.")
+  //Load json files, then keep them up to date when they change or on SIGHUP.
+  service.New(context.Background()).ListsReload()
+  go service.New(ctx).ListsWatch(5 * time.Second)
+  
+  hup := make(chan os.Signal, 1)
+  signal.Notify(hup, syscall.SIGHUP)
+  go func() {
+    for range hup {
+      log.Log.Println("Received SIGHUP, reloading lists")
+      service.New(context.Background()).ListsReload()
     }
+  }()
+  
+  //Purge deleted characters once they're past the purge window.
+  if system.Config.Character.PurgeDays > 0 {
+    go func() {
+      window := time.Duration(system.Config.Character.PurgeDays) * 24 * time.Hour
+      ticker := time.NewTicker(time.Hour)
+      defer ticker.Stop()
+      
+      for {
+        n, err := service.New(ctx).CharactersPurgeDeleted(time.Now().Add(-window))
+        if err != nil {
+          log.Log.Errorf("failed to purge deleted characters: %v", err)
+        }else if n > 0 {
+          log.Log.Printf("Purged %d deleted characters", n)
+        }
+        
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+        }
+      }
+    }()
   }
   
-  log.Log.Printf("Loading Admin list from %s", system.Config.Verify.AdminListFile)
-  if err := system.LoadAdminList(system.Config.Verify.AdminListFile); err != nil {
-    log.Log.Warnln("Failed to load Admin list.")
+  if system.Config.Anomaly.Enable && !service.ValidAnomalyAction(system.Config.Anomaly.Action) && system.Config.Anomaly.Action != "" {
+    log.Log.Fatalf("Anomaly.Action must be flag, quarantine or reject, not %q", system.Config.Anomaly.Action)
   }
   
-  //Connect database.
-  log.Log.Println("Connecting to database")
-  client, err := ent.Open("sqlite3", system.Config.Core.DBString)
-  if err != nil {
-    log.Log.Fatalf("failed to open connection to sqlite3: %v", err)
+  //Take backups on a schedule.
+  if system.Config.Backup.Enable {
+    if system.Config.Backup.Interval <= 0 {
+      log.Log.Fatalln("Backup.Interval must be more than 0 minutes")
+    }
+    go service.New(ctx).BackupsSchedule()
   }
-  if err := client.Schema.Create(context.Background(), schema.WithAtlas(true)); err != nil {
-		log.Log.Fatalf("failed to create schema resources: %v", err)
-	}
-  system.Client = client
-  defer system.Client.Close()
+  
+  //Mark game servers offline once they stop sending heartbeats.
+  go func() {
+    ticker := time.NewTicker(service.ServerTimeout())
+    defer ticker.Stop()
+    
+    for {
+      select {
+      case <-ctx.Done():
+        return
+      case <-ticker.C:
+      }
+      
+      n, err := service.New(ctx).ServersMarkOffline()
+      if err != nil {
+        log.Log.Errorf("failed to mark servers offline: %v", err)
+      }else if n > 0 {
+        log.Log.Printf("Marked %d servers offline after missed heartbeats", n)
+      }
+    }
+  }()
   
   //variables for web server
   var srv *http.Server
@@ -132,58 +208,175 @@ func main() {
     },
   }
   
-  //middleware
+  //middleware, signed bodies are only read once the client is past the rate limit and Log comes after so it knows the signer.
   router.Use(middleware.PanicRecovery)
-  router.Use(middleware.Log)
+  router.Use(middleware.RequestID)
+  if system.Config.Metrics.Enable {
+    router.Use(middleware.Metrics)
+  }
   if system.Config.RateLimit.Enable {
     router.Use(middleware.RateLimit)
   }
+  router.Use(middleware.Signature)
+  router.Use(middleware.Log)
+  
+  //metrics
+  if system.Config.Metrics.Enable {
+    metrics.ListSize("ip", func() float64 { return float64(system.IPListLen()) })
+    metrics.ListSize("map", func() float64 { return float64(system.MapListLen()) })
+    metrics.ListSize("admin", func() float64 { return float64(system.AdminListLen()) })
+    metrics.ListSize("ban", func() float64 {
+      n, _ := service.New(context.Background()).BansCount()
+      return float64(n)
+    })
+    router.HandleFunc("/metrics", middleware.Auth(system.ScopeMetrics, promhttp.Handler().ServeHTTP)).Methods(http.MethodGet)
+  }
   
   //api routes
   apic := controller.New(router.PathPrefix(system.Config.Core.RootPath).Subrouter())
-  apic.R.HandleFunc("/", middleware.Auth(apic.TestRoot)).Methods(http.MethodGet)
-  apic.R.HandleFunc("/ping", middleware.Auth(apic.GetPing)).Methods(http.MethodGet)
-  apic.R.HandleFunc("/map/{name}/{hash}", middleware.Auth(apic.GetMapVerify)).Methods(http.MethodGet)
-  apic.R.HandleFunc("/ban/{steamid:[0-9]+}", middleware.Auth(apic.GetBanVerify)).Methods(http.MethodGet)
-  apic.R.HandleFunc("/sc/{hash}", middleware.Auth(apic.GetSCVerify)).Methods(http.MethodGet)
+  apic.R.HandleFunc("/", middleware.Auth("", apic.TestRoot)).Methods(http.MethodGet)
+  apic.R.HandleFunc("/ping", middleware.Auth("", apic.GetPing)).Methods(http.MethodGet)
+  apic.R.HandleFunc("/map/{name}/{hash}", middleware.Auth("", apic.GetMapVerify)).Methods(http.MethodGet)
+  apic.R.HandleFunc("/sc/{hash}", middleware.Auth("", apic.GetSCVerify)).Methods(http.MethodGet)
+  
+  //ban routes
+  banc := controller.New(router.PathPrefix(system.Config.Core.RootPath+"/ban").Subrouter())
+  banc.R.HandleFunc("/", middleware.Auth(system.ScopeBanRead, banc.GetBans)).Methods(http.MethodGet)
+  banc.R.HandleFunc("/search", middleware.Auth(system.ScopeBanRead, banc.SearchBans)).Methods(http.MethodGet)
+  banc.R.HandleFunc("/{steamid:[0-9]+}", middleware.Auth(system.ScopeBanRead, banc.GetBanVerify)).Methods(http.MethodGet)
+  banc.R.HandleFunc("/", middleware.Auth(system.ScopeBanWrite, banc.PostBan)).Methods(http.MethodPost)
+  banc.R.HandleFunc("/{steamid:[0-9]+}", middleware.Auth(system.ScopeBanWrite, banc.DeleteBan)).Methods(http.MethodDelete)
   
   //character routes
   charc := controller.New(router.PathPrefix(system.Config.Core.RootPath+"/character").Subrouter())
-  charc.R.HandleFunc("/", middleware.Auth(charc.GetAllCharacters)).Methods(http.MethodGet)
-  charc.R.HandleFunc("/id/{uid}", middleware.Auth(charc.GetCharacterByID)).Methods(http.MethodGet)
-  charc.R.HandleFunc("/{steamid:[0-9]+}", middleware.Auth(charc.GetCharacters)).Methods(http.MethodGet)
-  charc.R.HandleFunc("/{steamid:[0-9]+}/{slot:[0-9]}", middleware.Auth(charc.GetCharacter)).Methods(http.MethodGet)
-  charc.R.HandleFunc("/export/{steamid:[0-9]+}/{slot:[0-9]}", middleware.Auth(charc.ExportCharacter)).Methods(http.MethodGet)
-  charc.R.HandleFunc("/", middleware.Auth(charc.PostCharacter)).Methods(http.MethodPost)
-  charc.R.HandleFunc("/{uid}", middleware.Auth(charc.PutCharacter)).Methods(http.MethodPut)
-  charc.R.HandleFunc("/{uid}", middleware.Auth(charc.DeleteCharacter)).Methods(http.MethodDelete)
+  charc.R.HandleFunc("/", middleware.Auth(system.ScopeCharRead, charc.GetAllCharacters)).Methods(http.MethodGet)
+  charc.R.HandleFunc("/id/{uid}", middleware.Auth(system.ScopeCharRead, charc.GetCharacterByID)).Methods(http.MethodGet)
+  charc.R.HandleFunc("/{steamid:[0-9]+}", middleware.Auth(system.ScopeCharRead, charc.GetCharacters)).Methods(http.MethodGet)
+  charc.R.HandleFunc("/{steamid:[0-9]+}/{slot:[0-9]}", middleware.Auth(system.ScopeCharRead, charc.GetCharacter)).Methods(http.MethodGet)
+  charc.R.HandleFunc("/export/{steamid:[0-9]+}/{slot:[0-9]}", middleware.Auth(system.ScopeCharRead, charc.ExportCharacter)).Methods(http.MethodGet)
+  charc.R.HandleFunc("/", middleware.Auth(system.ScopeCharWrite, charc.PostCharacter)).Methods(http.MethodPost)
+  charc.R.HandleFunc("/{uid}", middleware.Auth(system.ScopeCharWrite, charc.PutCharacter)).Methods(http.MethodPut)
+  charc.R.HandleFunc("/{uid}", middleware.Auth(system.ScopeCharWrite, charc.DeleteCharacter)).Methods(http.MethodDelete)
+  charc.R.HandleFunc("/{uid}/versions", middleware.Auth(system.ScopeCharRead, charc.GetCharacterVersions)).Methods(http.MethodGet)
+  charc.R.HandleFunc("/{uid}/rollback/{version:[0-9]+}", middleware.Auth(system.ScopeAdmin, charc.RollbackCharacter)).Methods(http.MethodPost)
+  charc.R.HandleFunc("/{uid}/lock", middleware.Auth(system.ScopeCharWrite, charc.LockCharacter)).Methods(http.MethodPost)
+  charc.R.HandleFunc("/{uid}/lock", middleware.Auth(system.ScopeCharWrite, charc.RenewCharacterLock)).Methods(http.MethodPatch)
+  charc.R.HandleFunc("/{uid}/lock", middleware.Auth(system.ScopeCharWrite, charc.UnlockCharacter)).Methods(http.MethodDelete)
+  
+  //server routes
+  srvc := controller.New(router.PathPrefix(system.Config.Core.RootPath+"/servers").Subrouter())
+  srvc.R.HandleFunc("/", middleware.Auth(system.ScopeServerRead, srvc.GetServers)).Methods(http.MethodGet)
+  srvc.R.HandleFunc("/", middleware.Auth(system.ScopeServerWrite, srvc.PostServer)).Methods(http.MethodPost)
+  srvc.R.HandleFunc("/{name}/heartbeat", middleware.Auth(system.ScopeServerWrite, srvc.PutServerHeartbeat)).Methods(http.MethodPut)
+  srvc.R.HandleFunc("/{name}", middleware.Auth(system.ScopeServerWrite, srvc.DeleteServer)).Methods(http.MethodDelete)
   
+  //admin routes
+  adminc := controller.New(router.PathPrefix(system.Config.Core.RootPath+"/admin").Subrouter())
+  adminc.R.HandleFunc("/character/deleted", middleware.Auth(system.ScopeAdmin, adminc.GetDeletedCharacters)).Methods(http.MethodGet)
+  adminc.R.HandleFunc("/character/{uid}/restore", middleware.Auth(system.ScopeAdmin, adminc.RestoreCharacter)).Methods(http.MethodPatch)
+  adminc.R.HandleFunc("/locks", middleware.Auth(system.ScopeAdmin, adminc.GetLocks)).Methods(http.MethodGet)
+  adminc.R.HandleFunc("/locks/{uid}", middleware.Auth(system.ScopeAdmin, adminc.ReleaseLock)).Methods(http.MethodDelete)
+  adminc.R.HandleFunc("/audit", middleware.Auth(system.ScopeAdmin, adminc.GetAudit)).Methods(http.MethodGet)
+  adminc.R.HandleFunc("/import", middleware.Auth(system.ScopeAdmin, middleware.NoTimeout(adminc.PostImport))).Methods(http.MethodPost).Name(middleware.LargeBodyRoute)
+  adminc.R.HandleFunc("/backup", middleware.Auth(system.ScopeAdmin, middleware.NoTimeout(adminc.GetBackup))).Methods(http.MethodGet)
+  adminc.R.HandleFunc("/backup/status", middleware.Auth(system.ScopeAdmin, adminc.GetBackupStatus)).Methods(http.MethodGet)
+  adminc.R.HandleFunc("/anomalies", middleware.Auth(system.ScopeAdmin, adminc.GetAnomalies)).Methods(http.MethodGet)
+  adminc.R.HandleFunc("/anomalies/{id}/{review:approve|dismiss}", middleware.Auth(system.ScopeAdmin, adminc.ReviewAnomaly)).Methods(http.MethodPost)
+  adminc.R.HandleFunc("/reload", middleware.Auth(system.ScopeAdmin, adminc.PostReload)).Methods(http.MethodPost)
+  
+  if system.Config.Cert.ClientCAFile != "" && !system.Config.Cert.Enable {
+    log.Log.Fatalln("Cert.ClientCAFile needs TLS, turn on Cert.Enable")
+  }
+  
+  var httpSrv *http.Server
   if system.Config.Cert.Enable {
-    cm := autocert.Manager{
-      Prompt: autocert.AcceptTOS,
-      HostPolicy: autocert.HostWhitelist(system.Config.Cert.Domain),
-      Cache: autocert.DirCache("./runtime/certs"),
+    httpSrv = &http.Server{
+      Addr: system.Config.Cert.HTTPAddress,
+      Handler: http.HandlerFunc(redirectHTTPS),
+    }
+    if httpSrv.Addr == "" {
+      httpSrv.Addr = ":http"
+    }
+    
+    static := system.Config.Cert.CertFile != "" || system.Config.Cert.KeyFile != ""
+    if static {
+      cr, err := newCertReloader(system.Config.Cert.CertFile, system.Config.Cert.KeyFile)
+      if err != nil {
+        log.Log.Fatalf("failed to load certificate: %v", err)
+      }
+      go cr.watch(ctx, 5 * time.Second)
+      
+      srv.TLSConfig.GetCertificate = cr.GetCertificate
+      if !system.Config.Cert.RedirectHTTP {
+        httpSrv = nil
+      }
+    }else{
+      cacheDir := system.Config.Cert.CacheDir
+      if cacheDir == "" {
+        cacheDir = "./runtime/certs"
+      }
+      
+      cm := autocert.Manager{
+        Prompt: autocert.AcceptTOS,
+        HostPolicy: autocert.HostWhitelist(system.Config.Cert.Domain),
+        Cache: autocert.DirCache(cacheDir),
+      }
+      
+      srv.TLSConfig.GetCertificate = cm.GetCertificate
+      srv.TLSConfig.NextProtos = append(srv.TLSConfig.NextProtos, acme.ALPNProto) // enable tls-alpn ACME challenges
+      httpSrv.Handler = cm.HTTPHandler(httpSrv.Handler) //answer http-01 challenges, redirect the rest
+    }
+    
+    if err := clientAuth(srv.TLSConfig, !static); err != nil {
+      log.Log.Fatalf("failed to set up client certificates: %v", err)
     }
   
-    srv.TLSConfig = &tls.Config{
-      GetCertificate: cm.GetCertificate,
-      NextProtos: append(srv.TLSConfig.NextProtos, acme.ALPNProto), // enable tls-alpn ACME challenges
+    if httpSrv != nil {
+      go func() {
+        log.Log.Printf("Redirecting HTTP on: %s", httpSrv.Addr)
+        if err := httpSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+          log.Log.Fatalf("failed to serve HTTP redirect: %v", err)
+        }
+      }()
     }
   
     go func() {
-      if err := http.ListenAndServe(":http", cm.HTTPHandler(nil)); err != nil {
-        log.Log.Fatalf("failed to serve autocert server: %v", err)
+      log.Log.Printf("Listening on: %s TLS", srv.Addr)
+      if err := srv.ListenAndServeTLS("", ""); err != nil && err != http.ErrServerClosed {
+        log.Log.Fatalf("failed to serve over HTTPS: %v", err)
       }
     }()
-  
-    log.Log.Printf("Listening on: %s TLS", srv.Addr)
-    if err := srv.ListenAndServeTLS("", ""); err != nil {
-      log.Log.Fatalf("failed to serve over HTTPS: %v", err)
-    }
   }else{
-    log.Log.Printf("Listening on: %s", srv.Addr)
-    if err := srv.ListenAndServe(); err != nil {
-      log.Log.Fatalf("failed to serve over HTTP: %v", err)
+    go func() {
+      log.Log.Printf("Listening on: %s", srv.Addr)
+      if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+        log.Log.Fatalf("failed to serve over HTTP: %v", err)
+      }
+    }()
+  }
+  
+  //Wait for a shutdown signal, then stop taking new connections and give
+  //in-flight requests up to Graceful minutes to finish before closing the database.
+  <-ctx.Done()
+  stop()
+  log.Log.Printf("Shutting down, waiting up to %v for requests to finish", system.Config.Core.Graceful * time.Minute)
+  
+  sctx, cancel := context.WithTimeout(context.Background(), system.Config.Core.Graceful * time.Minute)
+  defer cancel()
+  
+  if httpSrv != nil {
+    if err := httpSrv.Shutdown(sctx); err != nil {
+      log.Log.Errorf("failed to shutdown HTTP redirect: %v", err)
     }
   }
-}
\ No newline at end of file
+  
+  if err := srv.Shutdown(sctx); err != nil {
+    log.Log.Errorf("failed to shutdown server: %v", err)
+  }
+  
+  if err := system.Client.Close(); err != nil {
+    log.Log.Errorf("failed to close database: %v", err)
+  }
+  
+  log.Log.Println("Shutdown complete")
+}
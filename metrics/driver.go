@@ -0,0 +1,78 @@
+package metrics
+
+import (
+  "time"
+  "errors"
+  "context"
+  "database/sql"
+  
+  "entgo.io/ent/dialect"
+)
+
+//driver times every ent query and exec against the wrapped driver.
+type driver struct {
+  dialect.Driver
+}
+
+type tx struct {
+  dialect.Tx
+}
+
+func Driver(d dialect.Driver) dialect.Driver {
+  return &driver{d}
+}
+
+func observe(op string, start time.Time) {
+  QueryDuration.WithLabelValues(op).Observe(time.Since(start).Seconds())
+}
+
+func (d *driver) Exec(ctx context.Context, query string, args, v interface{}) error {
+  defer observe("exec", time.Now())
+  return d.Driver.Exec(ctx, query, args, v)
+}
+
+func (d *driver) Query(ctx context.Context, query string, args, v interface{}) error {
+  defer observe("query", time.Now())
+  return d.Driver.Query(ctx, query, args, v)
+}
+
+func (d *driver) Tx(ctx context.Context) (dialect.Tx, error) {
+  t, err := d.Driver.Tx(ctx)
+  if err != nil {
+    return nil, err
+  }
+  
+  return &tx{t}, nil
+}
+
+//BeginTx is needed for transactions with options, like the read only snapshot backups use.
+func (d *driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+  b, ok := d.Driver.(interface {
+    BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+  })
+  if !ok {
+    return nil, errors.New("driver does not support transaction options")
+  }
+  
+  t, err := b.BeginTx(ctx, opts)
+  if err != nil {
+    return nil, err
+  }
+  
+  return &tx{t}, nil
+}
+
+func (t *tx) Exec(ctx context.Context, query string, args, v interface{}) error {
+  defer observe("exec", time.Now())
+  return t.Tx.Exec(ctx, query, args, v)
+}
+
+func (t *tx) Query(ctx context.Context, query string, args, v interface{}) error {
+  defer observe("query", time.Now())
+  return t.Tx.Query(ctx, query, args, v)
+}
+
+func (t *tx) Commit() error {
+  defer observe("commit", time.Now())
+  return t.Tx.Commit()
+}
@@ -0,0 +1,81 @@
+package metrics
+
+import (
+  "github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+  Requests = prometheus.NewCounterVec(prometheus.CounterOpts{
+    Namespace: "nexus",
+    Name: "http_requests_total",
+    Help: "HTTP requests by route, method and status code.",
+  }, []string{"route", "method", "code"})
+  
+  RequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+    Namespace: "nexus",
+    Name: "http_request_duration_seconds",
+    Help: "HTTP request latency by route, method and status code.",
+    Buckets: prometheus.DefBuckets,
+  }, []string{"route", "method", "code"})
+  
+  Unauthorized = prometheus.NewCounterVec(prometheus.CounterOpts{
+    Namespace: "nexus",
+    Name: "auth_rejected_total",
+    Help: "Requests rejected by auth, by status code (401 or 403) and reason.",
+  }, []string{"code", "reason"})
+  
+  RateLimited = prometheus.NewCounter(prometheus.CounterOpts{
+    Namespace: "nexus",
+    Name: "rate_limited_total",
+    Help: "Requests rejected with 429 by the rate limiter.",
+  })
+  
+  CharacterSize = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+    Namespace: "nexus",
+    Name: "character_save_bytes",
+    Help: "Size of character data received, by operation.",
+    Buckets: prometheus.ExponentialBuckets(1024, 2, 10),
+  }, []string{"op"})
+  
+  QueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+    Namespace: "nexus",
+    Name: "db_query_duration_seconds",
+    Help: "Database operation latency, by operation.",
+    Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+  }, []string{"op"})
+  
+  BackupLastSuccess = prometheus.NewGauge(prometheus.GaugeOpts{
+    Namespace: "nexus",
+    Name: "backup_last_success_timestamp_seconds",
+    Help: "Unix time of the last successful scheduled backup.",
+  })
+  
+  BackupFailures = prometheus.NewCounter(prometheus.CounterOpts{
+    Namespace: "nexus",
+    Name: "backup_failures_total",
+    Help: "Scheduled backups that failed.",
+  })
+)
+
+func init() {
+  prometheus.MustRegister(
+    Requests,
+    RequestDuration,
+    Unauthorized,
+    RateLimited,
+    CharacterSize,
+    QueryDuration,
+    BackupLastSuccess,
+    BackupFailures,
+  )
+}
+
+//ListSize registers a gauge reporting the size of a loaded list when scraped.
+func ListSize(list string, size func() float64) {
+  prometheus.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+    Namespace: "nexus",
+    Name: "list_entries",
+    Help: "Number of entries in a loaded list.",
+    ConstLabels: prometheus.Labels{"list": list},
+  }, size))
+}
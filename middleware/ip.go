@@ -0,0 +1,90 @@
+package middleware
+
+import (
+  "net"
+  "strings"
+  "net/http"
+  
+  "github.com/msrevive/nexus2/system"
+)
+
+//parse an address from a forwarded header, which may be bracketed and carry a port.
+func parseHop(s string) net.IP {
+  s = strings.Trim(strings.TrimSpace(s), `"`)
+  if host, _, err := net.SplitHostPort(s); err == nil {
+    s = host
+  }
+  
+  return net.ParseIP(strings.Trim(s, "[]"))
+}
+
+//the for= hops of an RFC 7239 Forwarded header, in the order proxies added them.
+func forwardedFor(header []string) []string {
+  var hops []string
+  for _,h := range header {
+    for _,elem := range strings.Split(h, ",") {
+      for _,pair := range strings.Split(elem, ";") {
+        kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+        if len(kv) == 2 && strings.EqualFold(kv[0], "for") {
+          hops = append(hops, kv[1])
+        }
+      }
+    }
+  }
+  
+  return hops
+}
+
+func forwardedList(header []string) []string {
+  var hops []string
+  for _,h := range header {
+    hops = append(hops, strings.Split(h, ",")...)
+  }
+  
+  return hops
+}
+
+//walk the hops from the right, skipping our own proxies. The first hop we don't trust is the client,
+//anything left of it could have been made up by the client.
+func clientHop(peer net.IP, hops []string) net.IP {
+  client := peer
+  for i := len(hops)-1; i >= 0; i-- {
+    ip := parseHop(hops[i])
+    if ip == nil {
+      break
+    }
+    
+    client = ip
+    if !system.IsTrustedProxy(ip) {
+      break
+    }
+  }
+  
+  return client
+}
+
+//getIP finds the client's address. Forwarded headers are only believed when the request
+//came straight from one of Core.TrustedProxies.
+func getIP(r *http.Request) string {
+  host, _, err := net.SplitHostPort(r.RemoteAddr)
+  if err != nil {
+    host = r.RemoteAddr
+  }
+  
+  peer := net.ParseIP(host)
+  if peer == nil || !system.IsTrustedProxy(peer) {
+    return host
+  }
+  
+  if hops := forwardedFor(r.Header.Values("Forwarded")); len(hops) > 0 {
+    return clientHop(peer, hops).String()
+  }
+  if hops := forwardedList(r.Header.Values("X-Forwarded-For")); len(hops) > 0 {
+    return clientHop(peer, hops).String()
+  }
+  if ip := parseHop(r.Header.Get("X-Real-IP")); ip != nil {
+    return ip.String()
+  }
+  
+  return peer.String()
+}
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+  "net"
+  "reflect"
+  "testing"
+  "net/http/httptest"
+
+  "github.com/msrevive/nexus2/system"
+)
+
+func trustProxies(t *testing.T, list ...string) {
+  t.Helper()
+  if err := system.LoadTrustedProxies(list); err != nil {
+    t.Fatal(err)
+  }
+  t.Cleanup(func() {
+    system.LoadTrustedProxies(nil)
+  })
+}
+
+func TestForwardedFor(t *testing.T) {
+  tests := []struct {
+    name string
+    header []string
+    want []string
+  }{
+    {"none", nil, nil},
+    {"no for", []string{"proto=https;by=203.0.113.43"}, nil},
+    {"single", []string{"for=192.0.2.60;proto=http;by=203.0.113.43"}, []string{"192.0.2.60"}},
+    {"list", []string{"for=192.0.2.43, for=198.51.100.17"}, []string{"192.0.2.43", "198.51.100.17"}},
+    {"repeated header", []string{"for=192.0.2.43", "For=198.51.100.17"}, []string{"192.0.2.43", "198.51.100.17"}},
+    {"quoted ipv6", []string{`for="[2001:db8:cafe::17]:4711"`}, []string{`"[2001:db8:cafe::17]:4711"`}},
+  }
+
+  for _,tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      if got := forwardedFor(tt.header); !reflect.DeepEqual(got, tt.want) {
+        t.Errorf("forwardedFor(%q) = %q, want %q", tt.header, got, tt.want)
+      }
+    })
+  }
+}
+
+func TestClientHop(t *testing.T) {
+  trustProxies(t, "10.0.0.0/8", "fd00::/8")
+  peer := net.ParseIP("10.0.0.1")
+
+  tests := []struct {
+    name string
+    hops []string
+    want string
+  }{
+    {"client", []string{"198.51.100.7"}, "198.51.100.7"},
+    {"spoofed hop left of client", []string{"6.6.6.6", "198.51.100.7"}, "198.51.100.7"},
+    {"behind our proxies", []string{"198.51.100.7", "10.0.0.3", "10.0.0.2"}, "198.51.100.7"},
+    {"only our proxies", []string{"10.0.0.3", "10.0.0.2"}, "10.0.0.3"},
+    {"unparseable hop", []string{"198.51.100.7", "unknown"}, "10.0.0.1"},
+    {"with port", []string{"198.51.100.7:4711"}, "198.51.100.7"},
+    {"ipv6", []string{`"[2001:db8::1]:4711"`, "fd00::2"}, "2001:db8::1"},
+  }
+
+  for _,tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      if got := clientHop(peer, tt.hops).String(); got != tt.want {
+        t.Errorf("clientHop(%q) = %s, want %s", tt.hops, got, tt.want)
+      }
+    })
+  }
+}
+
+func TestGetIP(t *testing.T) {
+  trustProxies(t, "10.0.0.0/8")
+
+  tests := []struct {
+    name string
+    remote string
+    header map[string]string
+    want string
+  }{
+    {"direct", "198.51.100.7:5000", nil, "198.51.100.7"},
+    {"untrusted peer", "198.51.100.7:5000", map[string]string{"X-Forwarded-For": "6.6.6.6"}, "198.51.100.7"},
+    {"x-forwarded-for", "10.0.0.1:5000", map[string]string{"X-Forwarded-For": "6.6.6.6, 198.51.100.7"}, "198.51.100.7"},
+    {"forwarded first", "10.0.0.1:5000", map[string]string{"Forwarded": "for=198.51.100.7", "X-Forwarded-For": "6.6.6.6"}, "198.51.100.7"},
+    {"x-real-ip", "10.0.0.1:5000", map[string]string{"X-Real-IP": "198.51.100.7"}, "198.51.100.7"},
+    {"no headers", "10.0.0.1:5000", nil, "10.0.0.1"},
+  }
+
+  for _,tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      r := httptest.NewRequest("GET", "/", nil)
+      r.RemoteAddr = tt.remote
+      for k,v := range tt.header {
+        r.Header.Set(k, v)
+      }
+
+      if got := getIP(r); got != tt.want {
+        t.Errorf("getIP() = %s, want %s", got, tt.want)
+      }
+    })
+  }
+}
@@ -1,35 +1,34 @@
 package middleware
 
 import(
-  "net"
+  "context"
   "time"
-  "strings"
+  "sync"
+  "strconv"
   "net/http"
   "runtime/debug"
   
   "github.com/msrevive/nexus2/system"
   "github.com/msrevive/nexus2/log"
   "github.com/msrevive/nexus2/rate"
+  "github.com/msrevive/nexus2/metrics"
+  
+  "github.com/google/uuid"
+  "github.com/gorilla/mux"
 )
 
-var (
-  globalLimiter *rate.Limiter
-)
-
-func getIP(r *http.Request) string {
-  ip := r.Header.Get("X_Real_IP")
-  if ip == "" {
-    ips := strings.Split(r.Header.Get("X_Forwarded_For"), ", ")
-    if ips[0] != "" {
-       return ips[0]
-    }
+type ctxKey int
 
-    ip,_,_ = net.SplitHostPort(r.RemoteAddr)
-    return ip
-  }
+const (
+  keyNameCtx ctxKey = iota
+  requestIDCtx
+  signatureCtx
+)
 
-  return ip
-}
+var (
+  limiters *rate.Store
+  limitersOnce sync.Once
+)
 
 func setControlHeaders(w http.ResponseWriter) {
   w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PATCH, PUT, DELETE, OPTIONS")
@@ -40,12 +39,70 @@ func setControlHeaders(w http.ResponseWriter) {
   w.Header().Set("Access-Control-Max-Age", "7200")
 }
 
+//RequestID tags each request with an ID, taken from X-Request-ID when the client sends one, and echoes it back.
+func RequestID(next http.Handler) http.Handler {
+  return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    id := r.Header.Get("X-Request-ID")
+    if id == "" || len(id) > 128 {
+      id = uuid.NewString()
+    }
+    
+    w.Header().Set("X-Request-ID", id)
+    next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDCtx, id)))
+  })
+}
+
+//ReqID returns the ID RequestID gave the request.
+func ReqID(r *http.Request) string {
+  id,_ := r.Context().Value(requestIDCtx).(string)
+  return id
+}
+
 func Log(next http.Handler) http.Handler {
   return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
     setControlHeaders(w) //best place to set control headers?
     start := time.Now()
     next.ServeHTTP(w, r)
-    log.Log.Printf("%s %s from %s (%v)", r.Method, r.RequestURI, getIP(r), time.Since(start))
+    if key,ok := requestKey(r); ok {
+      log.Log.Printf("%s %s from %s with key %s (%v) [%s]", r.Method, r.RequestURI, getIP(r), key.Name, time.Since(start), ReqID(r))
+      return
+    }
+    log.Log.Printf("%s %s from %s (%v) [%s]", r.Method, r.RequestURI, getIP(r), time.Since(start), ReqID(r))
+  })
+}
+
+//statusWriter keeps the status code written so it can be reported once the request is done.
+type statusWriter struct {
+  http.ResponseWriter
+  code int
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+  w.code = code
+  w.ResponseWriter.WriteHeader(code)
+}
+
+//Unwrap lets http.ResponseController reach the connection underneath.
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+  return w.ResponseWriter
+}
+
+func Metrics(next http.Handler) http.Handler {
+  return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    route := "unknown"
+    if cr := mux.CurrentRoute(r); cr != nil {
+      if tpl, err := cr.GetPathTemplate(); err == nil {
+        route = tpl
+      }
+    }
+    
+    sw := &statusWriter{ResponseWriter: w, code: http.StatusOK}
+    start := time.Now()
+    next.ServeHTTP(sw, r)
+    
+    code := strconv.Itoa(sw.code)
+    metrics.Requests.WithLabelValues(route, r.Method, code).Inc()
+    metrics.RequestDuration.WithLabelValues(route, r.Method, code).Observe(time.Since(start).Seconds())
   })
 }
 
@@ -64,15 +121,45 @@ func PanicRecovery(next http.Handler) http.Handler {
   })
 }
 
+//requestKey finds who sent the request, by a valid signature, a verified client certificate and then by bearer key.
+func requestKey(r *http.Request) (*system.APIKey, bool) {
+  if sig,ok := requestSignature(r); ok && sig.key != nil {
+    return sig.key, true
+  }
+  
+  if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 {
+    if key,ok := system.APIKeyGetByCert(r.TLS.PeerCertificates[0]); ok {
+      return key, true
+    }
+  }
+  
+  return system.APIKeyGet(r.Header.Get("Authorization"))
+}
+
+//ClientID tells clients apart by API key name when they send a known key, otherwise by IP.
+func ClientID(r *http.Request) string {
+  if key,ok := requestKey(r); ok {
+    return key.Name
+  }
+  
+  return getIP(r)
+}
+
+//RateLimit runs before signatures are checked, so signed requests are limited by IP.
 func RateLimit(next http.Handler) http.Handler {
   return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-    if globalLimiter == nil {
-      globalLimiter = rate.NewLimiter(1, system.Config.RateLimit.MaxRequests, system.Config.RateLimit.MaxAge, 0)
-    }
+    limitersOnce.Do(func() {
+      limiters = rate.NewStore(1, system.Config.RateLimit.MaxRequests, system.Config.RateLimit.MaxAge, 0)
+    })
+    
+    id := ClientID(r)
+    max,_ := system.RateLimitListGet(id)
+    limiter := limiters.Get(id, max)
 
-    globalLimiter.CheckTime()
-    if globalLimiter.IsAllowed() == false {
-      log.Log.Println("Received too many requests.")
+    limiter.CheckTime()
+    if limiter.IsAllowed() == false {
+      log.Log.Printf("Received too many requests from %s.", getIP(r))
+      metrics.RateLimited.Inc()
       http.Error(w, http.StatusText(429), http.StatusTooManyRequests)
       return
     }
@@ -81,26 +168,65 @@ func RateLimit(next http.Handler) http.Handler {
   })
 }
 
-func Auth(next http.HandlerFunc) http.HandlerFunc {
+//KeyName returns the name of the API key used for the request, or "" if none was used.
+func KeyName(r *http.Request) string {
+  name,_ := r.Context().Value(keyNameCtx).(string)
+  return name
+}
+
+//HasScope reports whether the client authenticated with a key that has scope.
+func HasScope(r *http.Request, scope string) bool {
+  key,ok := requestKey(r)
+  return ok && key.HasScope(scope)
+}
+
+//Auth checks the client against the IP list and API keys, the key must have scope to use the route.
+func Auth(scope string, next http.HandlerFunc) http.HandlerFunc {
   return func(w http.ResponseWriter, r *http.Request) {
     ip := getIP(r)
     
     //IP Auth
     if system.Config.ApiAuth.EnforceIP {      
-      if _,ok := system.IPList[ip]; !ok {
+      if !system.IPListHas(ip) {
         log.Log.Printf("%s Is not authorized.", ip)
+        metrics.Unauthorized.WithLabelValues("401", "ip").Inc()
         http.Error(w, http.StatusText(401), http.StatusUnauthorized)
         return
       }
     }
     
+    //Signed requests must have a valid signature, whether or not keys are enforced.
+    if sig,ok := requestSignature(r); ok && sig.err != nil {
+      log.Log.Printf("%s failed signature check as %s: %v", ip, r.Header.Get(SignKeyHeader), sig.err)
+      if sig.err == errBodyTooLarge {
+        http.Error(w, http.StatusText(413), http.StatusRequestEntityTooLarge)
+        return
+      }
+      metrics.Unauthorized.WithLabelValues("401", "signature").Inc()
+      http.Error(w, http.StatusText(401), http.StatusUnauthorized)
+      return
+    }
+    
     //API Key Auth
+    key,ok := requestKey(r)
     if system.Config.ApiAuth.EnforceKey {
-      if r.Header.Get("Authorization") != system.Config.ApiAuth.Key {
+      if !ok {
         log.Log.Printf("%s failed API key check.", ip)
+        metrics.Unauthorized.WithLabelValues("401", "key").Inc()
         http.Error(w, http.StatusText(401), http.StatusUnauthorized)
         return
       }
+      
+      if !key.HasScope(scope) {
+        log.Log.Printf("%s key %s is missing scope %s.", ip, key.Name, scope)
+        metrics.Unauthorized.WithLabelValues("403", "scope").Inc()
+        http.Error(w, http.StatusText(403), http.StatusForbidden)
+        return
+      }
+    }
+    
+    if ok {
+      r = r.WithContext(context.WithValue(r.Context(), keyNameCtx, key.Name))
     }
 
     next(w, r)
@@ -108,6 +234,22 @@ func Auth(next http.HandlerFunc) http.HandlerFunc {
   }
 }
 
+//NoTimeout lifts the server's read and write timeouts for routes that move a whole database,
+//like backups and imports. Wrap it inside Auth so only clients that got past it can hold a connection open.
+func NoTimeout(next http.HandlerFunc) http.HandlerFunc {
+  return func(w http.ResponseWriter, r *http.Request) {
+    rc := http.NewResponseController(w)
+    if err := rc.SetReadDeadline(time.Time{}); err != nil {
+      log.Log.Warnf("Failed to lift the read timeout for %s: %v", r.URL.Path, err)
+    }
+    if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+      log.Log.Warnf("Failed to lift the write timeout for %s: %v", r.URL.Path, err)
+    }
+    
+    next(w, r)
+  }
+}
+
 func NoAuth(next http.HandlerFunc) http.HandlerFunc {
   return func(w http.ResponseWriter, r *http.Request) {
     next(w, r)
@@ -0,0 +1,266 @@
+package middleware
+
+import(
+  "io"
+  "os"
+  "fmt"
+  "sync"
+  "time"
+  "bytes"
+  "errors"
+  "context"
+  "strconv"
+  "net/http"
+  "crypto/hmac"
+  "crypto/sha256"
+  "encoding/hex"
+
+  "github.com/msrevive/nexus2/system"
+  "github.com/msrevive/nexus2/log"
+
+  "github.com/gorilla/mux"
+)
+
+//A signed request names its key in SignKeyHeader and sends the hex HMAC-SHA256, keyed with
+//the key's secret, of these lines joined by "\n":
+//
+//  METHOD
+//  /path?query
+//  unix timestamp, as sent in SignTimestampHeader
+//  nonce, as sent in SignNonceHeader
+//  hex SHA-256 of the body
+const (
+  SignKeyHeader = "X-Signature-Key"
+  SignTimestampHeader = "X-Signature-Timestamp"
+  SignNonceHeader = "X-Signature-Nonce"
+  SignatureHeader = "X-Signature"
+
+  defaultSignatureWindow = 300
+  defaultNonceCacheSize = 100000
+  maxMemBody = 1 << 20 //bodies bigger than this are hashed into a temp file instead of memory
+  maxSignedBody = 32 << 20 //well over a base64 encoded save of the largest .char file
+  maxSignedImport = 256 << 20 //as big as an import can be
+  largeBodyTimeout = 10 * time.Minute //how long a signed import has to arrive, before the key is known
+)
+
+//LargeBodyRoute names the routes that take signed bodies up to maxSignedImport, the rest are held to maxSignedBody.
+const LargeBodyRoute = "large-body"
+
+var (
+  errUnknownSigner = errors.New("unknown key")
+  errBadTimestamp = errors.New("bad timestamp")
+  errStaleTimestamp = errors.New("timestamp outside of the signature window")
+  errBadNonce = errors.New("nonce must be 16 to 128 characters")
+  errBadSignature = errors.New("signature doesn't match")
+  errBodyTooLarge = errors.New("body too large to sign")
+  errReplay = errors.New("nonce already used")
+  errNonceCacheFull = errors.New("too many nonces in the signature window")
+
+  nonces *nonceCache
+  noncesOnce sync.Once
+)
+
+//signature is the outcome of checking a signed request, Auth turns away requests with err set.
+type signature struct {
+  key *system.APIKey
+  err error
+}
+
+//Signature checks requests that carry a signature and notes who signed them, so they're
+//known by key everywhere else. Unsigned requests are passed on untouched, and so are requests
+//from addresses Auth will turn away, their bodies aren't worth reading.
+func Signature(next http.Handler) http.Handler {
+  return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    name := r.Header.Get(SignKeyHeader)
+    if name == "" || (system.Config.ApiAuth.EnforceIP && !system.IPListHas(getIP(r))) {
+      next.ServeHTTP(w, r)
+      return
+    }
+
+    noncesOnce.Do(func() {
+      size := system.Config.ApiAuth.NonceCacheSize
+      if size <= 0 {
+        size = defaultNonceCacheSize
+      }
+      nonces = newNonceCache(size)
+    })
+
+    limit := int64(maxSignedBody)
+    if route := mux.CurrentRoute(r); route != nil && route.GetName() == LargeBodyRoute {
+      limit = maxSignedImport
+      if err := http.NewResponseController(w).SetReadDeadline(time.Now().Add(largeBodyTimeout)); err != nil {
+        log.Log.Warnf("Failed to extend the read timeout for %s: %v", r.URL.Path, err)
+      }
+    }
+    
+    key, cleanup, err := verifySignature(w, r, name, limit)
+    defer cleanup()
+
+    next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), signatureCtx, signature{key, err})))
+  })
+}
+
+func requestSignature(r *http.Request) (signature, bool) {
+  sig,ok := r.Context().Value(signatureCtx).(signature)
+  return sig, ok
+}
+
+func signatureWindow() time.Duration {
+  if system.Config.ApiAuth.SignatureWindow > 0 {
+    return time.Duration(system.Config.ApiAuth.SignatureWindow) * time.Second
+  }
+
+  return defaultSignatureWindow * time.Second
+}
+
+//verifySignature checks the signature on r, the nonce is only used up once the signature matches.
+//The body is put back for the handler, cleanup must be called once the request is done.
+func verifySignature(w http.ResponseWriter, r *http.Request, name string, limit int64) (*system.APIKey, func(), error) {
+  cleanup := func() {}
+
+  key,ok := system.APIKeyGetBySigner(name)
+  if !ok {
+    return nil, cleanup, errUnknownSigner
+  }
+
+  ts := r.Header.Get(SignTimestampHeader)
+  unix, err := strconv.ParseInt(ts, 10, 64)
+  if err != nil {
+    return nil, cleanup, errBadTimestamp
+  }
+
+  window := signatureWindow()
+  signed := time.Unix(unix, 0)
+  if d := time.Since(signed); d > window || d < -window {
+    return nil, cleanup, errStaleTimestamp
+  }
+
+  nonce := r.Header.Get(SignNonceHeader)
+  if len(nonce) < 16 || len(nonce) > 128 {
+    return nil, cleanup, errBadNonce
+  }
+
+  sig, err := hex.DecodeString(r.Header.Get(SignatureHeader))
+  if err != nil {
+    return nil, cleanup, errBadSignature
+  }
+
+  sum, cleanup, err := hashBody(w, r, limit)
+  if err != nil {
+    return nil, cleanup, err
+  }
+
+  if !hmac.Equal(sig, sign(key.Secret, r.Method, r.URL.RequestURI(), ts, nonce, sum)) {
+    return nil, cleanup, errBadSignature
+  }
+
+  if err := nonces.add(name+"\n"+nonce, signed.Add(window)); err != nil {
+    return nil, cleanup, err
+  }
+
+  return key, cleanup, nil
+}
+
+//sign is the HMAC of the lines described above SignKeyHeader, sum being the SHA-256 of the body.
+func sign(secret, method, uri, ts, nonce string, sum []byte) []byte {
+  mac := hmac.New(sha256.New, []byte(secret))
+  fmt.Fprintf(mac, "%s\n%s\n%s\n%s\n%x", method, uri, ts, nonce, sum)
+  return mac.Sum(nil)
+}
+
+//hashBody reads the whole body to hash it and gives the handler a copy to read instead.
+//Bodies over limit are refused before they fill the disk.
+func hashBody(w http.ResponseWriter, r *http.Request, limit int64) ([]byte, func(), error) {
+  cleanup := func() {}
+  h := sha256.New()
+  if r.Body == nil || r.Body == http.NoBody {
+    return h.Sum(nil), cleanup, nil
+  }
+  r.Body = http.MaxBytesReader(w, r.Body, limit)
+
+  var buf bytes.Buffer
+  _, err := io.CopyN(io.MultiWriter(h, &buf), r.Body, maxMemBody)
+  if err == io.EOF {
+    r.Body = io.NopCloser(&buf)
+    return h.Sum(nil), cleanup, nil
+  }
+  if err != nil {
+    if int64(buf.Len()) >= limit {
+      err = errBodyTooLarge
+    }
+    return nil, cleanup, err
+  }
+
+  f, err := os.CreateTemp("", "nexus2-body-*")
+  if err != nil {
+    return nil, cleanup, err
+  }
+  cleanup = func() {
+    f.Close()
+    os.Remove(f.Name())
+  }
+
+  if _, err := f.Write(buf.Bytes()); err != nil {
+    return nil, cleanup, err
+  }
+  if n, err := io.Copy(io.MultiWriter(h, f), r.Body); err != nil {
+    if int64(buf.Len()) + n >= limit {
+      err = errBodyTooLarge
+    }
+    return nil, cleanup, err
+  }
+  if _, err := f.Seek(0, io.SeekStart); err != nil {
+    return nil, cleanup, err
+  }
+
+  r.Body = io.NopCloser(f)
+  return h.Sum(nil), cleanup, nil
+}
+
+//nonceCache remembers nonces until their timestamp falls out of the signature window, past that
+//the timestamp check turns replays away. Once it holds max nonces it refuses new ones rather than
+//forget one that could still be replayed.
+type nonceCache struct {
+  max int
+  seen map[string]time.Time
+  sweep time.Time
+  mutex sync.Mutex
+}
+
+func newNonceCache(max int) *nonceCache {
+  return &nonceCache{
+    max: max,
+    seen: make(map[string]time.Time),
+    sweep: time.Now(),
+  }
+}
+
+func (c *nonceCache) add(nonce string, expires time.Time) error {
+  c.mutex.Lock()
+  defer c.mutex.Unlock()
+
+  now := time.Now()
+  if len(c.seen) >= c.max || now.Sub(c.sweep) > time.Minute {
+    c.evict(now)
+  }
+
+  if exp,ok := c.seen[nonce]; ok && now.Before(exp) {
+    return errReplay
+  }
+  if len(c.seen) >= c.max {
+    return errNonceCacheFull
+  }
+
+  c.seen[nonce] = expires
+  return nil
+}
+
+func (c *nonceCache) evict(now time.Time) {
+  for nonce, exp := range c.seen {
+    if !now.Before(exp) {
+      delete(c.seen, nonce)
+    }
+  }
+
+  c.sweep = now
+}
@@ -0,0 +1,290 @@
+package middleware
+
+import (
+  "io"
+  "os"
+  "time"
+  "bytes"
+  "strconv"
+  "testing"
+  "net/http"
+  "crypto/hmac"
+  "crypto/sha256"
+  "encoding/hex"
+  "path/filepath"
+  "net/http/httptest"
+
+  "github.com/msrevive/nexus2/system"
+)
+
+func loadSigners(t *testing.T) {
+  t.Helper()
+  path := filepath.Join(t.TempDir(), "keys.json")
+  if err := os.WriteFile(path, []byte(`{"eu1": {"secret": "s3cret", "scopes": ["server:write"]}}`), 0600); err != nil {
+    t.Fatal(err)
+  }
+  if err := system.LoadKeyList(path); err != nil {
+    t.Fatal(err)
+  }
+
+  nonces = newNonceCache(100)
+}
+
+//signedRequest signs a request the way a client would, writing the canonical string out by hand.
+func signedRequest(method, target string, body []byte, secret, nonce string, ts time.Time) *http.Request {
+  r := httptest.NewRequest(method, target, bytes.NewReader(body))
+  unix := strconv.FormatInt(ts.Unix(), 10)
+  sum := sha256.Sum256(body)
+
+  mac := hmac.New(sha256.New, []byte(secret))
+  io.WriteString(mac, method+"\n"+r.URL.RequestURI()+"\n"+unix+"\n"+nonce+"\n"+hex.EncodeToString(sum[:]))
+
+  r.Header.Set(SignKeyHeader, "eu1")
+  r.Header.Set(SignTimestampHeader, unix)
+  r.Header.Set(SignNonceHeader, nonce)
+  r.Header.Set(SignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+  return r
+}
+
+func TestSign(t *testing.T) {
+  body := []byte(`{"slot":1}`)
+  sum := sha256.Sum256(body)
+  mac := hmac.New(sha256.New, []byte("s3cret"))
+  io.WriteString(mac, "PUT\n/api/v1/character/1?force=1\n1700000000\n0123456789abcdef\n"+hex.EncodeToString(sum[:]))
+
+  got := sign("s3cret", "PUT", "/api/v1/character/1?force=1", "1700000000", "0123456789abcdef", sum[:])
+  if !hmac.Equal(got, mac.Sum(nil)) {
+    t.Errorf("sign() = %x, want %x", got, mac.Sum(nil))
+  }
+}
+
+func TestVerifySignature(t *testing.T) {
+  loadSigners(t)
+  now := time.Now()
+  body := []byte(`{"size":3,"data":"AAAA"}`)
+
+  tests := []struct {
+    name string
+    req func() *http.Request
+    err error
+  }{
+    {"valid", func() *http.Request {
+      return signedRequest("PUT", "/api/v1/character/1", body, "s3cret", "nonce-valid-0001", now)
+    }, nil},
+    {"empty body", func() *http.Request {
+      return signedRequest("GET", "/api/v1/character/1?x=y", nil, "s3cret", "nonce-empty-0001", now)
+    }, nil},
+    {"unknown key", func() *http.Request {
+      r := signedRequest("PUT", "/api/v1/character/1", body, "s3cret", "nonce-unknown-01", now)
+      r.Header.Set(SignKeyHeader, "eu2")
+      return r
+    }, errUnknownSigner},
+    {"wrong secret", func() *http.Request {
+      return signedRequest("PUT", "/api/v1/character/1", body, "guess", "nonce-secret-001", now)
+    }, errBadSignature},
+    {"changed body", func() *http.Request {
+      r := signedRequest("PUT", "/api/v1/character/1", body, "s3cret", "nonce-body-00001", now)
+      r.Body = io.NopCloser(bytes.NewReader([]byte(`{"size":3,"data":"BBBB"}`)))
+      return r
+    }, errBadSignature},
+    {"changed query", func() *http.Request {
+      r := signedRequest("PUT", "/api/v1/character/1", body, "s3cret", "nonce-query-0001", now)
+      r.URL.RawQuery = "force=1"
+      return r
+    }, errBadSignature},
+    {"changed method", func() *http.Request {
+      r := signedRequest("PUT", "/api/v1/character/1", body, "s3cret", "nonce-method-001", now)
+      r.Method = "DELETE"
+      return r
+    }, errBadSignature},
+    {"bad timestamp", func() *http.Request {
+      r := signedRequest("PUT", "/api/v1/character/1", body, "s3cret", "nonce-badts-0001", now)
+      r.Header.Set(SignTimestampHeader, "yesterday")
+      return r
+    }, errBadTimestamp},
+    {"old timestamp", func() *http.Request {
+      return signedRequest("PUT", "/api/v1/character/1", body, "s3cret", "nonce-old-000001", now.Add(-10 * time.Minute))
+    }, errStaleTimestamp},
+    {"future timestamp", func() *http.Request {
+      return signedRequest("PUT", "/api/v1/character/1", body, "s3cret", "nonce-future-001", now.Add(10 * time.Minute))
+    }, errStaleTimestamp},
+    {"short nonce", func() *http.Request {
+      return signedRequest("PUT", "/api/v1/character/1", body, "s3cret", "short", now)
+    }, errBadNonce},
+    {"not hex", func() *http.Request {
+      r := signedRequest("PUT", "/api/v1/character/1", body, "s3cret", "nonce-nothex-001", now)
+      r.Header.Set(SignatureHeader, "zz")
+      return r
+    }, errBadSignature},
+  }
+
+  for _,tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      r := tt.req()
+      key, cleanup, err := verifySignature(httptest.NewRecorder(), r, r.Header.Get(SignKeyHeader), maxSignedBody)
+      defer cleanup()
+
+      if err != tt.err {
+        t.Fatalf("verifySignature() error = %v, want %v", err, tt.err)
+      }
+      if err == nil && key.Name != "eu1" {
+        t.Errorf("verifySignature() key = %s, want eu1", key.Name)
+      }
+    })
+  }
+}
+
+func TestVerifySignatureReplay(t *testing.T) {
+  loadSigners(t)
+  body := []byte(`{"size":3,"data":"AAAA"}`)
+  r := signedRequest("PUT", "/api/v1/character/1", body, "s3cret", "nonce-replay-001", time.Now())
+  replay := r.Clone(r.Context())
+  replay.Body = io.NopCloser(bytes.NewReader(body))
+
+  if _, cleanup, err := verifySignature(httptest.NewRecorder(), r, "eu1", maxSignedBody); err != nil {
+    t.Fatalf("first request: %v", err)
+  }else{
+    cleanup()
+  }
+
+  _, cleanup, err := verifySignature(httptest.NewRecorder(), replay, "eu1", maxSignedBody)
+  defer cleanup()
+  if err != errReplay {
+    t.Errorf("replayed request error = %v, want %v", err, errReplay)
+  }
+}
+
+//a bad signature mustn't use up the nonce, or anyone could burn a client's nonces.
+func TestVerifySignatureKeepsNonce(t *testing.T) {
+  loadSigners(t)
+  body := []byte(`{"size":3,"data":"AAAA"}`)
+
+  _, cleanup, err := verifySignature(httptest.NewRecorder(), signedRequest("PUT", "/api/v1/character/1", body, "guess", "nonce-kept-00001", time.Now()), "eu1", maxSignedBody)
+  cleanup()
+  if err != errBadSignature {
+    t.Fatalf("forged request error = %v, want %v", err, errBadSignature)
+  }
+
+  _, cleanup, err = verifySignature(httptest.NewRecorder(), signedRequest("PUT", "/api/v1/character/1", body, "s3cret", "nonce-kept-00001", time.Now()), "eu1", maxSignedBody)
+  defer cleanup()
+  if err != nil {
+    t.Errorf("genuine request: %v", err)
+  }
+}
+
+func TestVerifySignatureBody(t *testing.T) {
+  loadSigners(t)
+
+  tests := []struct {
+    name string
+    size int
+  }{
+    {"in memory", 1024},
+    {"spooled", maxMemBody + 1024},
+  }
+
+  for _,tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      body := bytes.Repeat([]byte("a"), tt.size)
+      r := signedRequest("POST", "/api/v1/admin/import", body, "s3cret", "nonce-body-"+tt.name, time.Now())
+
+      _, cleanup, err := verifySignature(httptest.NewRecorder(), r, "eu1", maxSignedBody)
+      defer cleanup()
+      if err != nil {
+        t.Fatal(err)
+      }
+
+      got, err := io.ReadAll(r.Body)
+      if err != nil {
+        t.Fatal(err)
+      }
+      if !bytes.Equal(got, body) {
+        t.Errorf("handler read %d bytes, want the %d signed", len(got), len(body))
+      }
+    })
+  }
+}
+
+func TestVerifySignatureTooLarge(t *testing.T) {
+  loadSigners(t)
+
+  tests := []struct {
+    name string
+    limit int64
+  }{
+    {"in memory", 1024},
+    {"spooled", maxMemBody + 1024},
+  }
+
+  for _,tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      body := bytes.Repeat([]byte("a"), int(tt.limit) + 1)
+      r := signedRequest("POST", "/api/v1/character/", body, "s3cret", "nonce-large-"+tt.name, time.Now())
+
+      _, cleanup, err := verifySignature(httptest.NewRecorder(), r, "eu1", tt.limit)
+      defer cleanup()
+      if err != errBodyTooLarge {
+        t.Errorf("verifySignature() error = %v, want %v", err, errBodyTooLarge)
+      }
+    })
+  }
+}
+
+//a body from an address Auth will turn away is never read.
+func TestSignatureSkipsDeniedIP(t *testing.T) {
+  loadSigners(t)
+  system.Config.ApiAuth.EnforceIP = true
+  t.Cleanup(func() {
+    system.Config.ApiAuth.EnforceIP = false
+  })
+
+  body := &countingReader{r: bytes.NewReader([]byte(`{"size":3,"data":"AAAA"}`))}
+  r := signedRequest("PUT", "/api/v1/character/1", nil, "s3cret", "nonce-denied-001", time.Now())
+  r.Body = io.NopCloser(body)
+
+  var checked bool
+  Signature(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    _, checked = requestSignature(r)
+  })).ServeHTTP(httptest.NewRecorder(), r)
+
+  if checked || body.n > 0 {
+    t.Errorf("signature checked %v after reading %d bytes, want it left alone", checked, body.n)
+  }
+}
+
+type countingReader struct {
+  r io.Reader
+  n int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+  n, err := c.r.Read(p)
+  c.n += n
+  return n, err
+}
+
+func TestNonceCache(t *testing.T) {
+  now := time.Now()
+  c := newNonceCache(2)
+
+  if err := c.add("a", now.Add(time.Minute)); err != nil {
+    t.Fatal(err)
+  }
+  if err := c.add("a", now.Add(time.Minute)); err != errReplay {
+    t.Errorf("repeated nonce error = %v, want %v", err, errReplay)
+  }
+  if err := c.add("b", now.Add(-time.Second)); err != nil {
+    t.Fatal(err)
+  }
+
+  //b has expired, so it makes room for c.
+  if err := c.add("c", now.Add(time.Minute)); err != nil {
+    t.Errorf("nonce after expiry: %v", err)
+  }
+  if err := c.add("d", now.Add(time.Minute)); err != errNonceCacheFull {
+    t.Errorf("nonce in a full cache error = %v, want %v", err, errNonceCacheFull)
+  }
+  if err := c.add("a", now.Add(time.Minute)); err != errReplay {
+    t.Errorf("full cache forgot a nonce, error = %v, want %v", err, errReplay)
+  }
+}
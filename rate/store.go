@@ -0,0 +1,86 @@
+package rate
+
+import (
+  "time"
+  "sync"
+)
+
+//Store keeps a Limiter per client so one busy client can't use up everyone else's bucket.
+type Store struct {
+  cost int
+  bucketSize int
+  duration time.Duration
+  throttleLimit int
+
+  limiters map[string]*storeEntry
+  sweep time.Time
+  mutex sync.Mutex
+}
+
+type storeEntry struct {
+  limiter *Limiter
+  seen time.Time
+}
+
+func NewStore(cost int, bucketSize int, dur time.Duration, throttle int) *Store {
+  return &Store{
+    cost: cost,
+    bucketSize: bucketSize,
+    duration: dur,
+    throttleLimit: throttle,
+
+    limiters: make(map[string]*storeEntry),
+    sweep: time.Now(),
+    mutex: sync.Mutex{},
+  }
+}
+
+//Get returns the limiter for id, creating it if needed. A bucketSize above 0 overrides
+//the store's default for this client.
+func (store *Store) Get(id string, bucketSize int) *Limiter {
+  store.mutex.Lock()
+  defer store.mutex.Unlock()
+
+  now := time.Now()
+  if now.Sub(store.sweep) > store.duration * time.Minute {
+    store.evict(now)
+  }
+
+  if bucketSize <= 0 {
+    bucketSize = store.bucketSize
+  }
+
+  entry, ok := store.limiters[id]
+  if !ok {
+    entry = &storeEntry{
+      limiter: NewLimiter(store.cost, bucketSize, store.duration, store.throttleLimit),
+    }
+    store.limiters[id] = entry
+  }
+  entry.seen = now
+
+  entry.limiter.mutex.Lock()
+  entry.limiter.bucketSize = bucketSize
+  entry.limiter.mutex.Unlock()
+
+  return entry.limiter
+}
+
+//a limiter that hasn't been used for a whole window would have been reset anyway,
+//so it's safe to drop it and start fresh next time.
+func (store *Store) evict(now time.Time) {
+  for id, entry := range store.limiters {
+    if now.Sub(entry.seen) > store.duration * time.Minute {
+      delete(store.limiters, id)
+    }
+  }
+
+  store.sweep = now
+}
+
+func (store *Store) Len() int {
+  store.mutex.Lock()
+  defer store.mutex.Unlock()
+
+  return len(store.limiters)
+}
@@ -15,7 +15,7 @@ type response struct {
 }
 
 type responseResult struct {
-	result bool `json:"result"`
+	Result bool `json:"result"`
 }
 
 type responseCharGet struct {
@@ -73,10 +73,44 @@ func Result(w http.ResponseWriter, b bool) {
 	Raw(w, true, http.StatusOK, nil, b)
 }
 
+//Accepted is for requests that were taken but not acted on yet, like a save held for review.
+func Accepted(w http.ResponseWriter, err error, details interface{}) {
+  Raw(w, false, http.StatusAccepted, err, details)
+}
+
 func BadRequest(w http.ResponseWriter, err error) {
   Raw(w, false, http.StatusBadRequest, err, nil)
 }
 
+func Forbidden(w http.ResponseWriter, err error) {
+  Raw(w, false, http.StatusForbidden, err, nil)
+}
+
+func NotFound(w http.ResponseWriter, err error) {
+  Raw(w, false, http.StatusNotFound, err, nil)
+}
+
+func Conflict(w http.ResponseWriter, err error) {
+  Raw(w, false, http.StatusConflict, err, nil)
+}
+
+//Unprocessable is for requests that parse but carry bad data, details says what's wrong.
+func Unprocessable(w http.ResponseWriter, err error, details interface{}) {
+  Raw(w, false, http.StatusUnprocessableEntity, err, details)
+}
+
+func Locked(w http.ResponseWriter, err error) {
+  Raw(w, false, http.StatusLocked, err, nil)
+}
+
+func PreconditionFailed(w http.ResponseWriter, err error) {
+  Raw(w, false, http.StatusPreconditionFailed, err, nil)
+}
+
+func PreconditionRequired(w http.ResponseWriter, err error) {
+  Raw(w, false, http.StatusPreconditionRequired, err, nil)
+}
+
 func Error(w http.ResponseWriter, err error) {
   Raw(w, false, http.StatusInternalServerError, err, nil)
 }
\ No newline at end of file
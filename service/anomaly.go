@@ -0,0 +1,177 @@
+package service
+
+import (
+  "fmt"
+  "time"
+  "errors"
+  "context"
+  "strings"
+  
+  "github.com/google/uuid"
+  
+  "github.com/msrevive/nexus2/ent"
+  "github.com/msrevive/nexus2/ent/anomaly"
+  "github.com/msrevive/nexus2/system"
+)
+
+//What happens to a save that breaks an anomaly rule.
+const (
+  AnomalyFlag = "flag"
+  AnomalyQuarantine = "quarantine"
+  AnomalyReject = "reject"
+)
+
+//Review states of an anomaly.
+const (
+  AnomalyOpen = "open"
+  AnomalyApproved = "approved"
+  AnomalyDismissed = "dismissed"
+)
+
+var ErrAnomalyReviewed = errors.New("anomaly has already been reviewed")
+
+//AnomalyError is returned when a save is quarantined or rejected by the anomaly rules.
+type AnomalyError struct {
+  Action string
+  Findings []string
+  anomaly ent.Anomaly
+}
+
+func (e *AnomalyError) Error() string {
+  if e.Action == AnomalyQuarantine {
+    return "save quarantined for review: " + strings.Join(e.Findings, "; ")
+  }
+  
+  return "save rejected: " + strings.Join(e.Findings, "; ")
+}
+
+func ValidAnomalyAction(action string) bool {
+  return action == AnomalyFlag || action == AnomalyQuarantine || action == AnomalyReject
+}
+
+func anomalyAction() string {
+  if system.Config.Anomaly.Action == "" {
+    return AnomalyFlag
+  }
+  
+  return system.Config.Anomaly.Action
+}
+
+//anomalyFindings compares an incoming save with the stored one and describes each rule it breaks.
+//Only the size rule is supported, gold, stat and item rules wait on the decoder in TODO.md.
+func anomalyFindings(old *ent.Character, size int, data string) []string {
+  cfg := system.Config.Anomaly
+  var findings []string
+  
+  if cfg.MaxSizeGrowth > 0 && size - old.Size > cfg.MaxSizeGrowth {
+    findings = append(findings, fmt.Sprintf("size grew by %d bytes, more than the %d allowed", size - old.Size, cfg.MaxSizeGrowth))
+  }
+  
+  return findings
+}
+
+//anomalyCheck runs the rules against a save. Flagged saves are recorded in the same transaction and
+//go through, quarantined and rejected ones stop the update with an *AnomalyError.
+func anomalyCheck(ctx context.Context, tx *ent.Tx, old *ent.Character, size int, data string, server string) error {
+  if !system.Config.Anomaly.Enable {
+    return nil
+  }
+  
+  findings := anomalyFindings(old, size, data)
+  if len(findings) == 0 {
+    return nil
+  }
+  
+  a := ent.Anomaly{
+    CharacterID: old.ID,
+    Steamid: old.Steamid,
+    Server: server,
+    Action: anomalyAction(),
+    Findings: findings,
+    Version: old.Version,
+    Size: size,
+  }
+  
+  switch a.Action {
+  case AnomalyFlag:
+    return anomalyCreate(ctx, tx.Anomaly, a)
+  case AnomalyQuarantine:
+    a.Data = data
+  }
+  
+  return &AnomalyError{Action: a.Action, Findings: findings, anomaly: a}
+}
+
+func anomalyCreate(ctx context.Context, client *ent.AnomalyClient, a ent.Anomaly) error {
+  return client.Create().
+  SetCharacterID(a.CharacterID).
+  SetSteamid(a.Steamid).
+  SetServer(a.Server).
+  SetAction(a.Action).
+  SetFindings(a.Findings).
+  SetVersion(a.Version).
+  SetSize(a.Size).
+  SetData(a.Data).
+  Exec(ctx)
+}
+
+//AnomaliesGet lists anomalies with the given status, or all of them if status is empty.
+func (s *service) AnomaliesGet(status string) ([]*ent.Anomaly, error) {
+  query := s.client.Anomaly.Query()
+  if status != "" {
+    query = query.Where(anomaly.Status(status))
+  }
+  
+  anomalies, err := query.Order(ent.Desc(anomaly.FieldCreatedAt)).All(s.ctx)
+  if err != nil {
+    return nil, err
+  }
+  
+  return anomalies, nil
+}
+
+//AnomalyReview closes an open anomaly. Approving a quarantined save applies it, as long as
+//the character hasn't been saved since, otherwise it fails with ErrVersionMismatch.
+func (s *service) AnomalyReview(id uuid.UUID, approve bool, reviewer string) (*ent.Anomaly, error) {
+  var a *ent.Anomaly
+  err := s.withTx(func(tx *ent.Tx) error {
+    old, err := tx.Anomaly.Get(s.ctx, id)
+    if err != nil {
+      return err
+    }
+    if old.Status != AnomalyOpen {
+      return ErrAnomalyReviewed
+    }
+    
+    status, action := AnomalyDismissed, AuditAnomalyDismiss
+    if approve {
+      status, action = AnomalyApproved, AuditAnomalyApprove
+      if old.Action == AnomalyQuarantine {
+        if _, err := characterUpdate(s.ctx, tx, old.CharacterID, old.Size, old.Data, old.Version, old.Server, false); err != nil {
+          return err
+        }
+      }
+    }
+    
+    a, err = old.Update().
+    SetStatus(status).
+    SetReviewedAt(time.Now()).
+    SetReviewedBy(reviewer).
+    Save(s.ctx)
+    if err != nil {
+      return err
+    }
+    
+    return auditTx(s.ctx, tx, ent.AuditEvent{
+      Action: action,
+      Steamid: a.Steamid,
+      CharacterID: &a.CharacterID,
+      SizeAfter: &a.Size,
+    })
+  })
+  if err != nil {
+    return nil, err
+  }
+  
+  return a, nil
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+  "time"
+  "context"
+  
+  "github.com/msrevive/nexus2/ent"
+  "github.com/msrevive/nexus2/ent/auditevent"
+)
+
+//Actions recorded in the audit log.
+const (
+  AuditCharacterCreate = "character.create"
+  AuditCharacterUpdate = "character.update"
+  AuditCharacterDelete = "character.delete"
+  AuditCharacterRollback = "character.rollback"
+  AuditCharacterRestore = "character.restore"
+  AuditCharacterPurge = "character.purge"
+  AuditCharacterImport = "character.import"
+  AuditBanCreate = "ban.create"
+  AuditBanLift = "ban.lift"
+  AuditLockAcquire = "lock.acquire"
+  AuditLockRenew = "lock.renew"
+  AuditLockRelease = "lock.release"
+  AuditLockForceRelease = "lock.force_release"
+  AuditAnomalyApprove = "anomaly.approve"
+  AuditAnomalyDismiss = "anomaly.dismiss"
+  AuditListsReload = "lists.reload"
+)
+
+//AuditFilter narrows down an audit search, zero values match everything.
+type AuditFilter struct {
+  Steamid string
+  Actor string
+  From time.Time
+  To time.Time
+  Limit int
+}
+
+//the most events a single search returns.
+const auditMaxLimit = 1000
+
+func auditCreate(ctx context.Context, client *ent.AuditEventClient, ev ent.AuditEvent) error {
+  return client.Create().
+  SetActor(ev.Actor).
+  SetServer(ev.Server).
+  SetAction(ev.Action).
+  SetSteamid(ev.Steamid).
+  SetNillableCharacterID(ev.CharacterID).
+  SetNillableSizeBefore(ev.SizeBefore).
+  SetNillableSizeAfter(ev.SizeAfter).
+  SetRequestID(ev.RequestID).
+  Exec(ctx)
+}
+
+type auditorKey struct{}
+
+//WithAuditor returns a copy of ctx whose changes are recorded with the actor, server and request ID from from.
+func WithAuditor(ctx context.Context, from ent.AuditEvent) context.Context {
+  return context.WithValue(ctx, auditorKey{}, from)
+}
+
+//fill in who made a change from the context it was made with.
+func auditFill(ctx context.Context, ev ent.AuditEvent) ent.AuditEvent {
+  if from,ok := ctx.Value(auditorKey{}).(ent.AuditEvent); ok {
+    ev.Actor = from.Actor
+    ev.Server = from.Server
+    ev.RequestID = from.RequestID
+  }
+  
+  return ev
+}
+
+//record ev in the transaction making the change, so there's never a change without its event.
+func auditTx(ctx context.Context, tx *ent.Tx, ev ent.AuditEvent) error {
+  return auditCreate(ctx, tx.AuditEvent, auditFill(ctx, ev))
+}
+
+//AuditRecord records an event for something that isn't a database change, like a list reload.
+func (s *service) AuditRecord(ev ent.AuditEvent) error {
+  return auditCreate(s.ctx, s.client.AuditEvent, auditFill(s.ctx, ev))
+}
+
+//AuditSearch returns the newest events matching the filter first.
+func (s *service) AuditSearch(f AuditFilter) ([]*ent.AuditEvent, error) {
+  query := s.client.AuditEvent.Query()
+  if f.Steamid != "" {
+    query = query.Where(auditevent.Steamid(f.Steamid))
+  }
+  if f.Actor != "" {
+    query = query.Where(auditevent.Actor(f.Actor))
+  }
+  if !f.From.IsZero() {
+    query = query.Where(auditevent.CreatedAtGTE(f.From))
+  }
+  if !f.To.IsZero() {
+    query = query.Where(auditevent.CreatedAtLT(f.To))
+  }
+  
+  if f.Limit <= 0 || f.Limit > auditMaxLimit {
+    f.Limit = auditMaxLimit
+  }
+  
+  events, err := query.
+  Order(ent.Desc(auditevent.FieldCreatedAt)).
+  Limit(f.Limit).
+  All(s.ctx)
+  if err != nil {
+    return nil, err
+  }
+  
+  return events, nil
+}
@@ -0,0 +1,488 @@
+package service
+
+import (
+  "io"
+  "os"
+  "bufio"
+  "bytes"
+  "errors"
+  "fmt"
+  "time"
+  "context"
+  "database/sql"
+  "archive/tar"
+  "compress/gzip"
+  
+  "github.com/msrevive/nexus2/ent"
+  "github.com/msrevive/nexus2/ent/character"
+  "github.com/msrevive/nexus2/ent/characterversion"
+  "github.com/msrevive/nexus2/ent/ban"
+  "github.com/msrevive/nexus2/ent/server"
+  "github.com/msrevive/nexus2/ent/auditevent"
+  "github.com/msrevive/nexus2/ent/anomaly"
+  "github.com/msrevive/nexus2/system"
+  
+  "entgo.io/ent/dialect"
+  "github.com/goccy/go-json"
+)
+
+//BackupFormat is bumped whenever the layout of a backup changes in a way older restores can't read.
+const BackupFormat = 1
+
+//rows read at a time while backing up.
+const backupBatch = 500
+
+var ErrDatabaseNotEmpty = errors.New("database is not empty, restore needs an empty database")
+
+//BackupManifest is the first file in every backup.
+type BackupManifest struct {
+  Format int `json:"format"`
+  Version string `json:"version"`
+  Driver string `json:"driver"`
+  CreatedAt time.Time `json:"created_at"`
+  Tables map[string]int `json:"tables"`
+}
+
+//backupTable knows how to page one table out as JSON lines and load those lines back in.
+//Character locks are leases, so they're left out of backups.
+type backupTable struct {
+  name string
+  dump func(ctx context.Context, tx *ent.Tx, offset int, enc *json.Encoder) (int, error)
+  load func(ctx context.Context, tx *ent.Tx, dec *json.Decoder) (int, error)
+  count func(ctx context.Context, client *ent.Client) (int, error)
+}
+
+//tables in the order they're restored, characters have to exist before their versions.
+var backupTables = []backupTable{
+  {
+    name: "characters",
+    dump: func(ctx context.Context, tx *ent.Tx, offset int, enc *json.Encoder) (int, error) {
+      rows, err := tx.Character.Query().Order(ent.Asc(character.FieldID)).Offset(offset).Limit(backupBatch).All(ctx)
+      if err != nil {
+        return 0, err
+      }
+      for _,row := range rows {
+        if err := enc.Encode(row); err != nil {
+          return 0, err
+        }
+      }
+      return len(rows), nil
+    },
+    load: func(ctx context.Context, tx *ent.Tx, dec *json.Decoder) (int, error) {
+      return eachRow(dec, func(line []byte) error {
+        var row ent.Character
+        if err := json.Unmarshal(line, &row); err != nil {
+          return err
+        }
+        
+        return tx.Character.Create().
+        SetID(row.ID).
+        SetSteamid(row.Steamid).
+        SetSlot(row.Slot).
+        SetSize(row.Size).
+        SetData(row.Data).
+        SetNillableDeletedAt(row.DeletedAt).
+        SetNillableLive(slotLive(row.DeletedAt)).
+        SetNillableCreatedAt(row.CreatedAt).
+        SetNillableUpdatedAt(row.UpdatedAt).
+        SetVersion(row.Version).
+        Exec(ctx)
+      })
+    },
+    count: func(ctx context.Context, client *ent.Client) (int, error) {
+      return client.Character.Query().Count(ctx)
+    },
+  },
+  {
+    name: "character_versions",
+    dump: func(ctx context.Context, tx *ent.Tx, offset int, enc *json.Encoder) (int, error) {
+      rows, err := tx.CharacterVersion.Query().Order(ent.Asc(characterversion.FieldID)).Offset(offset).Limit(backupBatch).All(ctx)
+      if err != nil {
+        return 0, err
+      }
+      for _,row := range rows {
+        if err := enc.Encode(row); err != nil {
+          return 0, err
+        }
+      }
+      return len(rows), nil
+    },
+    load: func(ctx context.Context, tx *ent.Tx, dec *json.Decoder) (int, error) {
+      return eachRow(dec, func(line []byte) error {
+        var row ent.CharacterVersion
+        if err := json.Unmarshal(line, &row); err != nil {
+          return err
+        }
+        
+        return tx.CharacterVersion.Create().
+        SetID(row.ID).
+        SetCharacterID(row.CharacterID).
+        SetVersion(row.Version).
+        SetSize(row.Size).
+        SetData(row.Data).
+        SetCreatedAt(row.CreatedAt).
+        Exec(ctx)
+      })
+    },
+    count: func(ctx context.Context, client *ent.Client) (int, error) {
+      return client.CharacterVersion.Query().Count(ctx)
+    },
+  },
+  {
+    name: "anomalies",
+    dump: func(ctx context.Context, tx *ent.Tx, offset int, enc *json.Encoder) (int, error) {
+      rows, err := tx.Anomaly.Query().Order(ent.Asc(anomaly.FieldID)).Offset(offset).Limit(backupBatch).All(ctx)
+      if err != nil {
+        return 0, err
+      }
+      for _,row := range rows {
+        if err := enc.Encode(row); err != nil {
+          return 0, err
+        }
+      }
+      return len(rows), nil
+    },
+    load: func(ctx context.Context, tx *ent.Tx, dec *json.Decoder) (int, error) {
+      return eachRow(dec, func(line []byte) error {
+        var row ent.Anomaly
+        if err := json.Unmarshal(line, &row); err != nil {
+          return err
+        }
+        
+        return tx.Anomaly.Create().
+        SetID(row.ID).
+        SetCharacterID(row.CharacterID).
+        SetSteamid(row.Steamid).
+        SetServer(row.Server).
+        SetAction(row.Action).
+        SetFindings(row.Findings).
+        SetStatus(row.Status).
+        SetVersion(row.Version).
+        SetSize(row.Size).
+        SetData(row.Data).
+        SetCreatedAt(row.CreatedAt).
+        SetNillableReviewedAt(row.ReviewedAt).
+        SetReviewedBy(row.ReviewedBy).
+        Exec(ctx)
+      })
+    },
+    count: func(ctx context.Context, client *ent.Client) (int, error) {
+      return client.Anomaly.Query().Count(ctx)
+    },
+  },
+  {
+    name: "bans",
+    dump: func(ctx context.Context, tx *ent.Tx, offset int, enc *json.Encoder) (int, error) {
+      rows, err := tx.Ban.Query().Order(ent.Asc(ban.FieldID)).Offset(offset).Limit(backupBatch).All(ctx)
+      if err != nil {
+        return 0, err
+      }
+      for _,row := range rows {
+        if err := enc.Encode(row); err != nil {
+          return 0, err
+        }
+      }
+      return len(rows), nil
+    },
+    load: func(ctx context.Context, tx *ent.Tx, dec *json.Decoder) (int, error) {
+      return eachRow(dec, func(line []byte) error {
+        var row ent.Ban
+        if err := json.Unmarshal(line, &row); err != nil {
+          return err
+        }
+        
+        return tx.Ban.Create().
+        SetID(row.ID).
+        SetSteamid(row.Steamid).
+        SetReason(row.Reason).
+        SetIssuedBy(row.IssuedBy).
+        SetCreatedAt(row.CreatedAt).
+        SetNillableExpiresAt(row.ExpiresAt).
+        Exec(ctx)
+      })
+    },
+    count: func(ctx context.Context, client *ent.Client) (int, error) {
+      return client.Ban.Query().Count(ctx)
+    },
+  },
+  {
+    name: "servers",
+    dump: func(ctx context.Context, tx *ent.Tx, offset int, enc *json.Encoder) (int, error) {
+      rows, err := tx.Server.Query().Order(ent.Asc(server.FieldID)).Offset(offset).Limit(backupBatch).All(ctx)
+      if err != nil {
+        return 0, err
+      }
+      for _,row := range rows {
+        if err := enc.Encode(row); err != nil {
+          return 0, err
+        }
+      }
+      return len(rows), nil
+    },
+    load: func(ctx context.Context, tx *ent.Tx, dec *json.Decoder) (int, error) {
+      return eachRow(dec, func(line []byte) error {
+        var row ent.Server
+        if err := json.Unmarshal(line, &row); err != nil {
+          return err
+        }
+        
+        return tx.Server.Create().
+        SetID(row.ID).
+        SetName(row.Name).
+        SetAddress(row.Address).
+        SetMap(row.Map).
+        SetPlayers(row.Players).
+        SetMaxPlayers(row.MaxPlayers).
+        SetOnline(row.Online).
+        SetLastHeartbeat(row.LastHeartbeat).
+        SetCreatedAt(row.CreatedAt).
+        Exec(ctx)
+      })
+    },
+    count: func(ctx context.Context, client *ent.Client) (int, error) {
+      return client.Server.Query().Count(ctx)
+    },
+  },
+  {
+    name: "audit_events",
+    dump: func(ctx context.Context, tx *ent.Tx, offset int, enc *json.Encoder) (int, error) {
+      rows, err := tx.AuditEvent.Query().Order(ent.Asc(auditevent.FieldID)).Offset(offset).Limit(backupBatch).All(ctx)
+      if err != nil {
+        return 0, err
+      }
+      for _,row := range rows {
+        if err := enc.Encode(row); err != nil {
+          return 0, err
+        }
+      }
+      return len(rows), nil
+    },
+    load: func(ctx context.Context, tx *ent.Tx, dec *json.Decoder) (int, error) {
+      return eachRow(dec, func(line []byte) error {
+        var row ent.AuditEvent
+        if err := json.Unmarshal(line, &row); err != nil {
+          return err
+        }
+        
+        return tx.AuditEvent.Create().
+        SetID(row.ID).
+        SetActor(row.Actor).
+        SetServer(row.Server).
+        SetAction(row.Action).
+        SetSteamid(row.Steamid).
+        SetNillableCharacterID(row.CharacterID).
+        SetNillableSizeBefore(row.SizeBefore).
+        SetNillableSizeAfter(row.SizeAfter).
+        SetRequestID(row.RequestID).
+        SetCreatedAt(row.CreatedAt).
+        Exec(ctx)
+      })
+    },
+    count: func(ctx context.Context, client *ent.Client) (int, error) {
+      return client.AuditEvent.Query().Count(ctx)
+    },
+  },
+}
+
+//BackupName is the file name for a backup taken at t.
+func BackupName(t time.Time) string {
+  return "nexus2-" + t.UTC().Format("20060102-150405") + ".tar.gz"
+}
+
+//Backup writes a tar.gz of every table as JSON lines to w. All tables are read in one
+//repeatable read transaction, so the backup is a consistent snapshot while the server keeps running.
+func (s *service) Backup(w io.Writer) (*BackupManifest, error) {
+  tx, err := s.client.BeginTx(s.ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+  if err != nil {
+    return nil, err
+  }
+  defer tx.Rollback()
+  
+  drv := system.Config.Core.DBDriver
+  if drv == "" {
+    drv = dialect.SQLite
+  }
+  
+  manifest := &BackupManifest{
+    Format: BackupFormat,
+    Version: system.Version,
+    Driver: drv,
+    CreatedAt: time.Now().UTC(),
+    Tables: make(map[string]int),
+  }
+  
+  //each table is spooled to a temp file first since tar needs to know its size up front.
+  var spools []*os.File
+  defer func() {
+    for _,f := range spools {
+      f.Close()
+      os.Remove(f.Name())
+    }
+  }()
+  
+  for _,t := range backupTables {
+    f, err := os.CreateTemp("", "nexus2-backup-*.jsonl")
+    if err != nil {
+      return nil, err
+    }
+    spools = append(spools, f)
+    
+    bw := bufio.NewWriter(f)
+    enc := json.NewEncoder(bw)
+    for offset := 0; ; {
+      n, err := t.dump(s.ctx, tx, offset, enc)
+      if err != nil {
+        return nil, fmt.Errorf("backing up %s: %w", t.name, err)
+      }
+      offset += n
+      manifest.Tables[t.name] = offset
+      if n < backupBatch {
+        break
+      }
+    }
+    
+    if err := bw.Flush(); err != nil {
+      return nil, err
+    }
+  }
+  
+  gz := gzip.NewWriter(w)
+  tw := tar.NewWriter(gz)
+  
+  head, err := json.MarshalIndent(manifest, "", "  ")
+  if err != nil {
+    return nil, err
+  }
+  if err := writeTarFile(tw, "manifest.json", manifest.CreatedAt, int64(len(head)), bytes.NewReader(head)); err != nil {
+    return nil, err
+  }
+  
+  for i,t := range backupTables {
+    f := spools[i]
+    size, err := f.Seek(0, io.SeekCurrent)
+    if err != nil {
+      return nil, err
+    }
+    if _, err := f.Seek(0, io.SeekStart); err != nil {
+      return nil, err
+    }
+    
+    if err := writeTarFile(tw, t.name+".jsonl", manifest.CreatedAt, size, f); err != nil {
+      return nil, err
+    }
+  }
+  
+  if err := tw.Close(); err != nil {
+    return nil, err
+  }
+  if err := gz.Close(); err != nil {
+    return nil, err
+  }
+  
+  return manifest, nil
+}
+
+//call fn with each JSON line read from dec, returning how many there were.
+func eachRow(dec *json.Decoder, fn func(line []byte) error) (int, error) {
+  n := 0
+  for {
+    var line json.RawMessage
+    if err := dec.Decode(&line); err != nil {
+      if err == io.EOF {
+        return n, nil
+      }
+      return n, err
+    }
+    
+    if err := fn(line); err != nil {
+      return n, err
+    }
+    n++
+  }
+}
+
+func writeTarFile(tw *tar.Writer, name string, mod time.Time, size int64, r io.Reader) error {
+  err := tw.WriteHeader(&tar.Header{
+    Name: name,
+    Mode: 0644,
+    Size: size,
+    ModTime: mod,
+  })
+  if err != nil {
+    return err
+  }
+  
+  _, err = io.CopyN(tw, r, size)
+  return err
+}
+
+//Restore loads a backup made by Backup into an empty database, in a single transaction.
+func (s *service) Restore(r io.Reader) (*BackupManifest, error) {
+  for _,t := range backupTables {
+    n, err := t.count(s.ctx, s.client)
+    if err != nil {
+      return nil, err
+    }
+    if n > 0 {
+      return nil, ErrDatabaseNotEmpty
+    }
+  }
+  
+  gz, err := gzip.NewReader(r)
+  if err != nil {
+    return nil, err
+  }
+  defer gz.Close()
+  tr := tar.NewReader(gz)
+  
+  hdr, err := tr.Next()
+  if err != nil {
+    return nil, err
+  }
+  if hdr.Name != "manifest.json" {
+    return nil, errors.New("backup is missing its manifest")
+  }
+  
+  var manifest BackupManifest
+  if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+    return nil, err
+  }
+  if manifest.Format > BackupFormat {
+    return nil, fmt.Errorf("backup format %d is newer than this version of nexus2 can read (%d)", manifest.Format, BackupFormat)
+  }
+  
+  tables := make(map[string]backupTable)
+  for _,t := range backupTables {
+    tables[t.name+".jsonl"] = t
+  }
+  
+  err = s.withTx(func(tx *ent.Tx) error {
+    for {
+      hdr, err := tr.Next()
+      if err == io.EOF {
+        return nil
+      }
+      if err != nil {
+        return err
+      }
+      
+      t, ok := tables[hdr.Name]
+      if !ok {
+        return fmt.Errorf("unknown file %s in backup", hdr.Name)
+      }
+      
+      n, err := t.load(s.ctx, tx, json.NewDecoder(bufio.NewReader(tr)))
+      if err != nil {
+        return fmt.Errorf("restoring %s: %w", t.name, err)
+      }
+      if want, ok := manifest.Tables[t.name]; ok && want != n {
+        return fmt.Errorf("restoring %s: backup has %d rows, manifest says %d", t.name, n, want)
+      }
+    }
+  })
+  if err != nil {
+    return nil, err
+  }
+  
+  return &manifest, nil
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+  "time"
+  
+  "github.com/msrevive/nexus2/ent"
+  "github.com/msrevive/nexus2/ent/ban"
+  "github.com/msrevive/nexus2/ent/predicate"
+)
+
+//a ban applies until it expires, bans without an expiry are permanent.
+func banActive() predicate.Ban {
+  return ban.Or(
+    ban.ExpiresAtIsNil(),
+    ban.ExpiresAtGT(time.Now()),
+  )
+}
+
+func (s *service) BanIsActive(sid string) (bool, error) {
+  ok, err := s.client.Ban.Query().Where(
+    ban.And(
+      ban.Steamid(sid),
+      banActive(),
+    ),
+  ).Exist(s.ctx)
+  if err != nil {
+    return false, err
+  }
+  
+  return ok, nil
+}
+
+func (s *service) BansCount() (int, error) {
+  n, err := s.client.Ban.Query().Where(banActive()).Count(s.ctx)
+  if err != nil {
+    return 0, err
+  }
+  
+  return n, nil
+}
+
+func (s *service) BansGetAll(all bool) ([]*ent.Ban, error) {
+  query := s.client.Ban.Query()
+  if !all {
+    query = query.Where(banActive())
+  }
+  
+  bans, err := query.Order(ent.Desc(ban.FieldCreatedAt)).All(s.ctx)
+  if err != nil {
+    return nil, err
+  }
+  
+  return bans, nil
+}
+
+func (s *service) BansSearch(q string, all bool) ([]*ent.Ban, error) {
+  query := s.client.Ban.Query().Where(
+    ban.Or(
+      ban.SteamidContains(q),
+      ban.ReasonContainsFold(q),
+      ban.IssuedByContainsFold(q),
+    ),
+  )
+  if !all {
+    query = query.Where(banActive())
+  }
+  
+  bans, err := query.Order(ent.Desc(ban.FieldCreatedAt)).All(s.ctx)
+  if err != nil {
+    return nil, err
+  }
+  
+  return bans, nil
+}
+
+func (s *service) BanCreate(newBan ent.Ban) (*ent.Ban, error) {
+  var b *ent.Ban
+  err := s.withTx(func(tx *ent.Tx) error {
+    var err error
+    b, err = tx.Ban.Create().
+    SetSteamid(newBan.Steamid).
+    SetReason(newBan.Reason).
+    SetIssuedBy(newBan.IssuedBy).
+    SetNillableExpiresAt(newBan.ExpiresAt).
+    Save(s.ctx)
+    if err != nil {
+      return err
+    }
+    
+    return auditTx(s.ctx, tx, ent.AuditEvent{
+      Action: AuditBanCreate,
+      Steamid: b.Steamid,
+    })
+  })
+  if err != nil {
+    return nil, err
+  }
+  
+  return b, nil
+}
+
+//lifting a ban expires it instead of deleting it so there's still a record of it.
+func (s *service) BanLift(sid string) (int, error) {
+  var n int
+  err := s.withTx(func(tx *ent.Tx) error {
+    var err error
+    n, err = tx.Ban.Update().Where(
+      ban.And(
+        ban.Steamid(sid),
+        banActive(),
+      ),
+    ).SetExpiresAt(time.Now()).Save(s.ctx)
+    if err != nil || n == 0 {
+      return err
+    }
+    
+    return auditTx(s.ctx, tx, ent.AuditEvent{
+      Action: AuditBanLift,
+      Steamid: sid,
+    })
+  })
+  if err != nil {
+    return 0, err
+  }
+  
+  return n, nil
+}
+
+//import a steamid list, like the old bans.json, skipping anyone who already has a ban on record
+//so lifted bans aren't brought back on the next import.
+func (s *service) BansImport(list map[string]bool, issuer string) (int, error) {
+  var n int
+  err := s.withTx(func(tx *ent.Tx) error {
+    for sid := range list {
+      ok, err := tx.Ban.Query().Where(
+        ban.Steamid(sid),
+      ).Exist(s.ctx)
+      if err != nil {
+        return err
+      }
+      if ok {
+        continue
+      }
+      
+      _, err = tx.Ban.Create().
+      SetSteamid(sid).
+      SetIssuedBy(issuer).
+      Save(s.ctx)
+      if err != nil {
+        return err
+      }
+      n++
+    }
+    
+    return nil
+  })
+  if err != nil {
+    return 0, err
+  }
+  
+  return n, nil
+}
@@ -1,15 +1,32 @@
 package service
 
 import (
+  "fmt"
+  "time"
+  "errors"
+  "context"
+  
   //"entgo.io/ent/dialect/sql"
   "github.com/google/uuid"
+  "github.com/lib/pq"
+  "github.com/mattn/go-sqlite3"
+  "github.com/go-sql-driver/mysql"
   
   "github.com/msrevive/nexus2/ent"
   "github.com/msrevive/nexus2/ent/character"
+  "github.com/msrevive/nexus2/ent/characterlock"
+  "github.com/msrevive/nexus2/ent/predicate"
+)
+
+var (
+  ErrCharacterExists = errors.New("a character already exists in this slot")
+  ErrVersionMismatch = errors.New("character has changed since it was loaded")
 )
 
 func (s *service) CharactersGetAll() ([]*ent.Character, error) {
-  chars, err := s.client.Character.Query().All(s.ctx)
+  chars, err := s.client.Character.Query().Where(
+    character.DeletedAtIsNil(),
+  ).All(s.ctx)
   if err != nil {
     return nil, err
   }
@@ -19,7 +36,10 @@ func (s *service) CharactersGetAll() ([]*ent.Character, error) {
 
 func (s *service) CharactersGetBySteamid(sid string) ([]*ent.Character, error) {
   chars, err := s.client.Character.Query().Where(
-    character.Steamid(sid),
+    character.And(
+      character.Steamid(sid),
+      character.DeletedAtIsNil(),
+    ),
   ).All(s.ctx)
   if err != nil {
     return nil, err
@@ -33,6 +53,7 @@ func (s *service) CharacterGetBySteamidSlot(sid string, slt int) (*ent.Character
     character.And(
       character.Steamid(sid),
       character.Slot(slt),
+      character.DeletedAtIsNil(),
     ),
   ).Only(s.ctx)
   if err != nil {
@@ -43,7 +64,12 @@ func (s *service) CharacterGetBySteamidSlot(sid string, slt int) (*ent.Character
 }
 
 func (s *service) CharacterGetByID(id uuid.UUID) (*ent.Character, error) {
-  char, err := s.client.Character.Get(s.ctx, id)
+  char, err := s.client.Character.Query().Where(
+    character.And(
+      character.ID(id),
+      character.DeletedAtIsNil(),
+    ),
+  ).Only(s.ctx)
   if err != nil {
     return nil, err
   }
@@ -51,37 +77,332 @@ func (s *service) CharacterGetByID(id uuid.UUID) (*ent.Character, error) {
   return char, nil
 }
 
+//slotLive is what a character with the given deletion time keeps in its live column.
+func slotLive(deletedAt *time.Time) *bool {
+  if deletedAt != nil {
+    return nil
+  }
+  
+  live := true
+  return &live
+}
+
+//A slot can only hold one live character. Deleted characters stay in it beside the new one,
+//restorable until CharactersPurgeDeleted removes them.
 func (s *service) CharacterCreate(newChar ent.Character) (*ent.Character, error) {
-  char, err := s.client.Character.Create().
-  SetSteamid(newChar.Steamid).
-  SetSlot(newChar.Slot).
-  SetSize(newChar.Size).
-  SetData(newChar.Data).
-  Save(s.ctx)
+  return s.characterCreateAs(AuditCharacterCreate, newChar)
+}
+
+//create a character and record it in the audit log as action.
+func (s *service) characterCreateAs(action string, newChar ent.Character) (*ent.Character, error) {
+  var char *ent.Character
+  err := s.withTx(func(tx *ent.Tx) error {
+    taken, err := tx.Character.Query().Where(
+      character.And(
+        character.Steamid(newChar.Steamid),
+        character.Slot(newChar.Slot),
+        character.DeletedAtIsNil(),
+      ),
+    ).Exist(s.ctx)
+    if err != nil {
+      return err
+    }
+    if taken {
+      return ErrCharacterExists
+    }
+    
+    char, err = tx.Character.Create().
+    SetSteamid(newChar.Steamid).
+    SetSlot(newChar.Slot).
+    SetSize(newChar.Size).
+    SetData(newChar.Data).
+    SetLive(true).
+    Save(s.ctx)
+    if err != nil {
+      return err
+    }
+    
+    return auditTx(s.ctx, tx, ent.AuditEvent{
+      Action: action,
+      Steamid: char.Steamid,
+      CharacterID: &char.ID,
+      SizeAfter: &char.Size,
+    })
+  })
+  if err != nil {
+    if ent.IsConstraintError(err) {
+      return nil, ErrCharacterExists
+    }
+    return nil, err
+  }
+  
+  return char, nil
+}
+
+//writeConflict reports whether err comes from another transaction writing at the same time, a lock
+//it couldn't get, a deadlock, or a unique index the other write got to first.
+func writeConflict(err error) bool {
+  if ent.IsConstraintError(err) {
+    return true
+  }
+  
+  var lerr sqlite3.Error
+  if errors.As(err, &lerr) {
+    return lerr.Code == sqlite3.ErrLocked || lerr.Code == sqlite3.ErrBusy
+  }
+  
+  var perr *pq.Error
+  if errors.As(err, &perr) {
+    return perr.Code == "40001" || perr.Code == "40P01" //serialization failure, deadlock
+  }
+  
+  var merr *mysql.MySQLError
+  if errors.As(err, &merr) {
+    return merr.Number == 1205 || merr.Number == 1213 //lock wait timeout, deadlock
+  }
+  
+  return false
+}
+
+//AnyVersion skips the version check in CharacterUpdate.
+const AnyVersion = -1
+
+//characters saved before versions were added have none, which reads back as 0.
+func characterVersion(v int) predicate.Character {
+  if v == 0 {
+    return character.Or(
+      character.VersionIsNil(),
+      character.Version(0),
+    )
+  }
+  
+  return character.Version(v)
+}
+
+//write new data over old, as long as nobody else has written to it since old was read.
+func characterWrite(ctx context.Context, tx *ent.Tx, old *ent.Character, size int, data string) (*ent.Character, error) {
+  n, err := tx.Character.Update().Where(
+    character.And(
+      character.ID(old.ID),
+      characterVersion(old.Version),
+    ),
+  ).
+  SetSize(size).
+  SetData(data).
+  SetVersion(old.Version + 1).
+  Save(ctx)
+  if err != nil {
+    return nil, err
+  }
+  
+  if n == 0 {
+    return nil, ErrVersionMismatch
+  }
+  
+  return tx.Character.Get(ctx, old.ID)
+}
+
+//CharacterUpdate overwrites a character if it's still at the given version, or at any version with AnyVersion.
+//It's refused if a server other than server holds the lock on it, and checked against the anomaly rules.
+func (s *service) CharacterUpdate(uid uuid.UUID, updateChar ent.Character, version int, server string) (*ent.Character, error) {
+  return s.characterUpdateAs(AuditCharacterUpdate, uid, updateChar, version, server)
+}
+
+//update a character and record it in the audit log as action.
+func (s *service) characterUpdateAs(action string, uid uuid.UUID, updateChar ent.Character, version int, server string) (*ent.Character, error) {
+  var char *ent.Character
+  err := s.withTx(func(tx *ent.Tx) error {
+    before, err := tx.Character.Get(s.ctx, uid)
+    if err != nil {
+      return err
+    }
+    
+    char, err = characterUpdate(s.ctx, tx, uid, updateChar.Size, updateChar.Data, version, server, true)
+    if err != nil {
+      return err
+    }
+    
+    return auditTx(s.ctx, tx, ent.AuditEvent{
+      Action: action,
+      Steamid: char.Steamid,
+      CharacterID: &char.ID,
+      SizeBefore: &before.Size,
+      SizeAfter: &char.Size,
+    })
+  })
   if err != nil {
+    //quarantined and rejected saves never reach the character, so they're recorded once the update is rolled back.
+    var aerr *AnomalyError
+    if errors.As(err, &aerr) {
+      if rerr := anomalyCreate(s.ctx, s.client.Anomaly, aerr.anomaly); rerr != nil {
+        return nil, fmt.Errorf("%w: recording anomaly: %v", err, rerr)
+      }
+    }
+    //a save that collides with another one is treated as having lost the race, the client reloads and tries again.
+    if writeConflict(err) {
+      return nil, ErrVersionMismatch
+    }
     return nil, err
   }
   
   return char, nil
 }
 
-func (s *service) CharacterUpdate(uid uuid.UUID, updateChar ent.Character) (*ent.Character, error) {
-  char, err := s.client.Character.UpdateOneID(uid).
-  SetSize(updateChar.Size).
-  SetData(updateChar.Data).
-  Save(s.ctx)
+func characterUpdate(ctx context.Context, tx *ent.Tx, uid uuid.UUID, size int, data string, version int, server string, rules bool) (*ent.Character, error) {
+  old, err := tx.Character.Query().Where(
+    character.And(
+      character.ID(uid),
+      character.DeletedAtIsNil(),
+    ),
+  ).Only(ctx)
+  if err != nil {
+    return nil, err
+  }
+  
+  if err := lockCheck(ctx, tx, uid, server); err != nil {
+    return nil, err
+  }
+  
+  if version != AnyVersion && version != old.Version {
+    return nil, ErrVersionMismatch
+  }
+  
+  if rules {
+    if err := anomalyCheck(ctx, tx, old, size, data, server); err != nil {
+      return nil, err
+    }
+  }
+  
+  char, err := characterWrite(ctx, tx, old, size, data)
   if err != nil {
     return nil, err
   }
   
+  //only the write that wins a race gets this far, so the loser never adds a version.
+  if err := snapshotCharacter(ctx, tx, old); err != nil {
+    return nil, err
+  }
+  
   return char, nil
 }
 
-func (s *service) CharacterDelete(uid uuid.UUID) (error) {
-  err := s.client.Character.DeleteOneID(uid).Exec(s.ctx)
+//characters are only tombstoned here, CharactersPurgeDeleted removes them for good.
+//Only the server holding the lock can delete a locked character, and its lock goes with it.
+func (s *service) CharacterDelete(uid uuid.UUID, server string) (error) {
+  return s.withTx(func(tx *ent.Tx) error {
+    char, err := tx.Character.Query().Where(
+      character.And(
+        character.ID(uid),
+        character.DeletedAtIsNil(),
+      ),
+    ).Only(s.ctx)
+    if err != nil {
+      return err
+    }
+    
+    if err := lockCheck(s.ctx, tx, uid, server); err != nil {
+      return err
+    }
+    
+    if err := char.Update().SetDeletedAt(time.Now()).ClearLive().Exec(s.ctx); err != nil {
+      return err
+    }
+    
+    _, err = tx.CharacterLock.Delete().Where(characterlock.CharacterID(uid)).Exec(s.ctx)
+    if err != nil {
+      return err
+    }
+    
+    return auditTx(s.ctx, tx, ent.AuditEvent{
+      Action: AuditCharacterDelete,
+      Steamid: char.Steamid,
+      CharacterID: &char.ID,
+      SizeBefore: &char.Size,
+    })
+  })
+}
+
+func (s *service) CharactersGetDeleted() ([]*ent.Character, error) {
+  chars, err := s.client.Character.Query().Where(
+    character.DeletedAtNotNil(),
+  ).Order(ent.Desc(character.FieldDeletedAt)).All(s.ctx)
   if err != nil {
-    return err
+    return nil, err
   }
   
-  return nil
-}
\ No newline at end of file
+  return chars, nil
+}
+
+//CharacterRestore brings back a deleted character, unless another character has taken its slot since.
+func (s *service) CharacterRestore(uid uuid.UUID) (*ent.Character, error) {
+  var char *ent.Character
+  err := s.withTx(func(tx *ent.Tx) error {
+    old, err := tx.Character.Query().Where(
+      character.And(
+        character.ID(uid),
+        character.DeletedAtNotNil(),
+      ),
+    ).Only(s.ctx)
+    if err != nil {
+      return err
+    }
+    
+    char, err = old.Update().ClearDeletedAt().SetLive(true).Save(s.ctx)
+    if err != nil {
+      return err
+    }
+    
+    return auditTx(s.ctx, tx, ent.AuditEvent{
+      Action: AuditCharacterRestore,
+      Steamid: char.Steamid,
+      CharacterID: &char.ID,
+      SizeAfter: &char.Size,
+    })
+  })
+  if err != nil {
+    if ent.IsConstraintError(err) {
+      return nil, ErrCharacterExists
+    }
+    return nil, err
+  }
+  
+  return char, nil
+}
+
+//hard delete every character that was tombstoned before the given time, along with its history.
+func (s *service) CharactersPurgeDeleted(before time.Time) (int, error) {
+  var n int
+  err := s.withTx(func(tx *ent.Tx) error {
+    chars, err := tx.Character.Query().Where(
+      character.DeletedAtLT(before),
+    ).All(s.ctx)
+    if err != nil {
+      return err
+    }
+    
+    for _,char := range chars {
+      if err := characterRemove(s.ctx, tx, char.ID); err != nil {
+        return err
+      }
+      
+      err := auditCreate(s.ctx, tx.AuditEvent, ent.AuditEvent{
+        Actor: "purge",
+        Action: AuditCharacterPurge,
+        Steamid: char.Steamid,
+        CharacterID: &char.ID,
+        SizeBefore: &char.Size,
+      })
+      if err != nil {
+        return err
+      }
+    }
+    
+    n = len(chars)
+    return nil
+  })
+  if err != nil {
+    return 0, err
+  }
+  
+  return n, nil
+}
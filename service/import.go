@@ -0,0 +1,130 @@
+package service
+
+import (
+  "fmt"
+  "encoding/base64"
+  
+  "github.com/google/uuid"
+  
+  "github.com/msrevive/nexus2/ent"
+  "github.com/msrevive/nexus2/helper"
+)
+
+//What to do when an imported file is for a slot that already has a character.
+const (
+  ImportSkip = "skip"
+  ImportOverwrite = "overwrite"
+)
+
+//the server identity imports write as, so they're turned away from characters a server has locked.
+const importServer = "import"
+
+type ImportEntry struct {
+  File string `json:"file"`
+  Steamid string `json:"steamid,omitempty"`
+  Slot int `json:"slot"`
+  Size int `json:"size"`
+  Result string `json:"result"`
+  CharacterID *uuid.UUID `json:"character_id,omitempty"`
+  SizeBefore *int `json:"size_before,omitempty"`
+  Error string `json:"error,omitempty"`
+}
+
+type ImportResult struct {
+  DryRun bool `json:"dry_run"`
+  Created int `json:"created"`
+  Updated int `json:"updated"`
+  Skipped int `json:"skipped"`
+  Failed int `json:"failed"`
+  Entries []ImportEntry `json:"entries"`
+}
+
+func ValidImportPolicy(policy string) bool {
+  return policy == ImportSkip || policy == ImportOverwrite
+}
+
+//CharactersImport upserts characters from legacy .char files. Each file is imported on its own,
+//so one bad file doesn't stop the rest. With dryRun nothing is written.
+func (s *service) CharactersImport(files []helper.CharFile, policy string, dryRun bool) (*ImportResult, error) {
+  if !ValidImportPolicy(policy) {
+    return nil, fmt.Errorf("unknown conflict policy %q", policy)
+  }
+  
+  res := &ImportResult{DryRun: dryRun}
+  for _,f := range files {
+    entry := s.importFile(f, policy, dryRun)
+    switch entry.Result {
+    case "created":
+      res.Created++
+    case "updated":
+      res.Updated++
+    case "skipped":
+      res.Skipped++
+    default:
+      res.Failed++
+    }
+    res.Entries = append(res.Entries, entry)
+  }
+  
+  return res, nil
+}
+
+func (s *service) importFile(f helper.CharFile, policy string, dryRun bool) ImportEntry {
+  entry := ImportEntry{File: f.Name, Size: len(f.Data)}
+  fail := func(err error) ImportEntry {
+    entry.Result = "failed"
+    entry.Error = err.Error()
+    return entry
+  }
+  
+  steamid, slot, err := helper.ParseCharFilename(f.Name)
+  if err != nil {
+    return fail(err)
+  }
+  entry.Steamid = steamid
+  entry.Slot = slot
+  
+  char := ent.Character{
+    Steamid: steamid,
+    Slot: slot,
+    Size: len(f.Data),
+    Data: base64.StdEncoding.EncodeToString(f.Data),
+  }
+  
+  old, err := s.CharacterGetBySteamidSlot(steamid, slot)
+  if err != nil && !ent.IsNotFound(err) {
+    return fail(err)
+  }
+  
+  if old == nil {
+    entry.Result = "created"
+    if dryRun {
+      return entry
+    }
+    
+    created, err := s.characterCreateAs(AuditCharacterImport, char)
+    if err != nil {
+      return fail(err)
+    }
+    entry.CharacterID = &created.ID
+    return entry
+  }
+  
+  entry.CharacterID = &old.ID
+  entry.SizeBefore = &old.Size
+  if policy == ImportSkip {
+    entry.Result = "skipped"
+    return entry
+  }
+  
+  entry.Result = "updated"
+  if dryRun {
+    return entry
+  }
+  
+  if _, err := s.characterUpdateAs(AuditCharacterImport, old.ID, char, AnyVersion, importServer); err != nil {
+    return fail(err)
+  }
+  
+  return entry
+}
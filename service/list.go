@@ -0,0 +1,117 @@
+package service
+
+import (
+  "os"
+  "fmt"
+  "time"
+  "strings"
+  
+  "github.com/msrevive/nexus2/system"
+  "github.com/msrevive/nexus2/log"
+)
+
+type listFile struct {
+  name string
+  path string
+  load func(string) error
+}
+
+//the lists in use with the current config.
+func listFiles() []listFile {
+  var lists []listFile
+  
+  if system.Config.ApiAuth.EnforceIP {
+    lists = append(lists, listFile{"IP", system.Config.ApiAuth.IPListFile, system.LoadIPList})
+  }
+  
+  //keys are needed for signed requests and client certificates even when they aren't enforced.
+  if system.Config.ApiAuth.KeyFile != "" {
+    lists = append(lists, listFile{"Key", system.Config.ApiAuth.KeyFile, system.LoadKeyList})
+  }
+  
+  if system.Config.Verify.EnforceMap {
+    lists = append(lists, listFile{"Map", system.Config.Verify.MapListFile, system.LoadMapList})
+  }
+  
+  if system.Config.Verify.EnforceBan {
+    lists = append(lists, listFile{"Ban", system.Config.Verify.BanListFile, system.LoadBanList})
+  }
+  
+  lists = append(lists, listFile{"Admin", system.Config.Verify.AdminListFile, system.LoadAdminList})
+  
+  if system.Config.RateLimit.Enable && system.Config.RateLimit.OverrideFile != "" {
+    lists = append(lists, listFile{"Rate limit", system.Config.RateLimit.OverrideFile, system.LoadRateLimitList})
+  }
+  
+  return lists
+}
+
+func (s *service) listReload(l listFile) error {
+  if err := l.load(l.path); err != nil {
+    log.Log.Warnf("Failed to load %s list from %s, keeping the current list: %v", l.name, l.path, err)
+    return err
+  }
+  
+  log.Log.Printf("Loaded %s list from %s", l.name, l.path)
+  
+  //Bans are kept in the database now, bring over anything new in the ban list file.
+  if l.name == "Ban" {
+    n, err := s.BansImport(system.BanListCopy(), "banlist")
+    if err != nil {
+      log.Log.Warnf("Failed to import Ban list: %v", err)
+      return err
+    }
+    
+    if n > 0 {
+      log.Log.Printf("Imported %d bans from %s", n, l.path)
+    }
+  }
+  
+  return nil
+}
+
+//reload every list in use, a list that fails to load keeps its current entries.
+func (s *service) ListsReload() error {
+  var failed []string
+  for _,l := range listFiles() {
+    if err := s.listReload(l); err != nil {
+      failed = append(failed, l.name)
+    }
+  }
+  
+  if len(failed) > 0 {
+    return fmt.Errorf("failed to reload %s list", strings.Join(failed, ", "))
+  }
+  
+  return nil
+}
+
+//poll the list files and reload any that changed on disk, until the service context is done.
+func (s *service) ListsWatch(interval time.Duration) {
+  modTimes := make(map[string]time.Time)
+  for _,l := range listFiles() {
+    if info, err := os.Stat(l.path); err == nil {
+      modTimes[l.path] = info.ModTime()
+    }
+  }
+  
+  ticker := time.NewTicker(interval)
+  defer ticker.Stop()
+  
+  for {
+    select {
+    case <-s.ctx.Done():
+      return
+    case <-ticker.C:
+      for _,l := range listFiles() {
+        info, err := os.Stat(l.path)
+        if err != nil || info.ModTime().Equal(modTimes[l.path]) {
+          continue
+        }
+        
+        modTimes[l.path] = info.ModTime()
+        s.listReload(l)
+      }
+    }
+  }
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+  "time"
+  "errors"
+  "context"
+  
+  "github.com/google/uuid"
+  
+  "github.com/msrevive/nexus2/ent"
+  "github.com/msrevive/nexus2/ent/character"
+  "github.com/msrevive/nexus2/ent/characterlock"
+)
+
+var (
+  ErrCharacterLocked = errors.New("character is locked by another server")
+  ErrLockNotHeld = errors.New("server does not hold the lock on this character")
+)
+
+//the lock on a character, if one is held and hasn't expired.
+func activeLock(ctx context.Context, tx *ent.Tx, uid uuid.UUID) (*ent.CharacterLock, error) {
+  lock, err := tx.CharacterLock.Query().Where(
+    characterlock.And(
+      characterlock.CharacterID(uid),
+      characterlock.ExpiresAtGT(time.Now()),
+    ),
+  ).Only(ctx)
+  if err != nil {
+    if ent.IsNotFound(err) {
+      return nil, nil
+    }
+    return nil, err
+  }
+  
+  return lock, nil
+}
+
+//writes are only turned away when another server holds the lock, unlocked characters can be written by anyone.
+func lockCheck(ctx context.Context, tx *ent.Tx, uid uuid.UUID, server string) error {
+  lock, err := activeLock(ctx, tx, uid)
+  if err != nil {
+    return err
+  }
+  
+  if lock != nil && lock.Server != server {
+    return ErrCharacterLocked
+  }
+  
+  return nil
+}
+
+//the steamid of a locked character for its audit events, deleted characters can still hold locks.
+func lockSteamid(ctx context.Context, tx *ent.Tx, uid uuid.UUID) (string, error) {
+  char, err := tx.Character.Get(ctx, uid)
+  if err != nil {
+    return "", err
+  }
+  
+  return char.Steamid, nil
+}
+
+//CharacterLock gives server the lock on a character for ttl, taking it over if the last holder let it expire.
+func (s *service) CharacterLock(uid uuid.UUID, server string, ttl time.Duration) (*ent.CharacterLock, error) {
+  var lock *ent.CharacterLock
+  err := s.withTx(func(tx *ent.Tx) error {
+    char, err := tx.Character.Query().Where(
+      character.And(
+        character.ID(uid),
+        character.DeletedAtIsNil(),
+      ),
+    ).Only(s.ctx)
+    if err != nil {
+      return err
+    }
+    
+    old, err := tx.CharacterLock.Query().Where(characterlock.CharacterID(uid)).Only(s.ctx)
+    if err != nil && !ent.IsNotFound(err) {
+      return err
+    }
+    
+    now := time.Now()
+    switch {
+    case old == nil:
+      lock, err = tx.CharacterLock.Create().
+      SetCharacterID(uid).
+      SetServer(server).
+      SetExpiresAt(now.Add(ttl)).
+      Save(s.ctx)
+    case old.Server != server:
+      if old.ExpiresAt.After(now) {
+        return ErrCharacterLocked
+      }
+      
+      lock, err = old.Update().
+      SetServer(server).
+      SetExpiresAt(now.Add(ttl)).
+      SetCreatedAt(now).
+      Save(s.ctx)
+    default:
+      lock, err = old.Update().SetExpiresAt(now.Add(ttl)).Save(s.ctx)
+    }
+    if err != nil {
+      return err
+    }
+    
+    return auditTx(s.ctx, tx, ent.AuditEvent{
+      Action: AuditLockAcquire,
+      Steamid: char.Steamid,
+      CharacterID: &uid,
+    })
+  })
+  if err != nil {
+    if ent.IsConstraintError(err) {
+      return nil, ErrCharacterLocked
+    }
+    return nil, err
+  }
+  
+  return lock, nil
+}
+
+//CharacterLockRenew extends a lock server still holds, an expired lock has to be taken again.
+func (s *service) CharacterLockRenew(uid uuid.UUID, server string, ttl time.Duration) (*ent.CharacterLock, error) {
+  var lock *ent.CharacterLock
+  err := s.withTx(func(tx *ent.Tx) error {
+    old, err := activeLock(s.ctx, tx, uid)
+    if err != nil {
+      return err
+    }
+    
+    if old == nil || old.Server != server {
+      return ErrLockNotHeld
+    }
+    
+    lock, err = old.Update().SetExpiresAt(time.Now().Add(ttl)).Save(s.ctx)
+    if err != nil {
+      return err
+    }
+    
+    sid, err := lockSteamid(s.ctx, tx, uid)
+    if err != nil {
+      return err
+    }
+    
+    return auditTx(s.ctx, tx, ent.AuditEvent{
+      Action: AuditLockRenew,
+      Steamid: sid,
+      CharacterID: &uid,
+    })
+  })
+  if err != nil {
+    return nil, err
+  }
+  
+  return lock, nil
+}
+
+func (s *service) CharacterUnlock(uid uuid.UUID, server string) error {
+  return s.withTx(func(tx *ent.Tx) error {
+    n, err := tx.CharacterLock.Delete().Where(
+      characterlock.And(
+        characterlock.CharacterID(uid),
+        characterlock.Server(server),
+      ),
+    ).Exec(s.ctx)
+    if err != nil {
+      return err
+    }
+    
+    if n == 0 {
+      return ErrLockNotHeld
+    }
+    
+    sid, err := lockSteamid(s.ctx, tx, uid)
+    if err != nil {
+      return err
+    }
+    
+    return auditTx(s.ctx, tx, ent.AuditEvent{
+      Action: AuditLockRelease,
+      Steamid: sid,
+      CharacterID: &uid,
+    })
+  })
+}
+
+func (s *service) LocksGetActive() ([]*ent.CharacterLock, error) {
+  locks, err := s.client.CharacterLock.Query().
+  Where(characterlock.ExpiresAtGT(time.Now())).
+  Order(ent.Asc(characterlock.FieldExpiresAt)).
+  All(s.ctx)
+  if err != nil {
+    return nil, err
+  }
+  
+  return locks, nil
+}
+
+//LockForceRelease drops the lock on a character no matter who holds it.
+func (s *service) LockForceRelease(uid uuid.UUID) error {
+  return s.withTx(func(tx *ent.Tx) error {
+    sid, err := lockSteamid(s.ctx, tx, uid)
+    if err != nil {
+      return err
+    }
+    
+    _, err = tx.CharacterLock.Delete().
+    Where(characterlock.CharacterID(uid)).
+    Exec(s.ctx)
+    if err != nil {
+      return err
+    }
+    
+    return auditTx(s.ctx, tx, ent.AuditEvent{
+      Action: AuditLockForceRelease,
+      Steamid: sid,
+      CharacterID: &uid,
+    })
+  })
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+  "time"
+  "context"
+  
+  "entgo.io/ent/dialect"
+  entsql "entgo.io/ent/dialect/sql"
+  "entgo.io/ent/dialect/sql/schema"
+  
+  "github.com/msrevive/nexus2/ent"
+  "github.com/msrevive/nexus2/ent/character"
+  "github.com/msrevive/nexus2/ent/migrate"
+  "github.com/msrevive/nexus2/system"
+  "github.com/msrevive/nexus2/log"
+)
+
+//Migrate brings the database schema up to date. Older databases can have more than one character
+//in a slot, so the schema is first created without the unique slot index, characters that don't
+//know whether they're live are told, duplicates are merged, and only then is the index added.
+func (s *service) Migrate() error {
+  if err := s.client.Schema.Create(s.ctx, schema.WithAtlas(true), schema.WithHooks(withoutUniqueSlot)); err != nil {
+    return err
+  }
+  
+  _, err := s.client.Character.Update().Where(
+    character.And(
+      character.DeletedAtIsNil(),
+      character.LiveIsNil(),
+    ),
+  ).SetLive(true).Save(s.ctx)
+  if err != nil {
+    return err
+  }
+  
+  n, err := s.CharactersDedupe()
+  if err != nil {
+    return err
+  }
+  if n > 0 {
+    log.Log.Warnf("Archived %d duplicate characters", n)
+  }
+  
+  return s.client.Schema.Create(s.ctx, schema.WithAtlas(true))
+}
+
+func withoutUniqueSlot(next schema.Creator) schema.Creator {
+  return schema.CreateFunc(func(ctx context.Context, tables ...*schema.Table) error {
+    indexes := migrate.CharactersTable.Indexes
+    defer func() { migrate.CharactersTable.Indexes = indexes }()
+    
+    migrate.CharactersTable.Indexes = nil
+    for _,idx := range indexes {
+      if idx.Unique && len(idx.Columns) == 3 && idx.Columns[0].Name == character.FieldSteamid && idx.Columns[1].Name == character.FieldSlot {
+        continue
+      }
+      migrate.CharactersTable.Indexes = append(migrate.CharactersTable.Indexes, idx)
+    }
+    
+    return next.Create(ctx, tables...)
+  })
+}
+
+//CharactersDedupe keeps the newest live character in each slot and archives the rest as versions of it.
+//The most recently updated wins, then the last one saved. Deleted characters are left alone.
+func (s *service) CharactersDedupe() (int, error) {
+  var slots []struct {
+    Steamid string `json:"steamid"`
+    Slot int `json:"slot"`
+    Count int `json:"count"`
+  }
+  err := s.client.Character.Query().
+  Where(character.DeletedAtIsNil()).
+  GroupBy(character.FieldSteamid, character.FieldSlot).
+  Aggregate(ent.Count()).
+  Scan(s.ctx, &slots)
+  if err != nil {
+    return 0, err
+  }
+  
+  var n int
+  for _,slot := range slots {
+    if slot.Count < 2 {
+      continue
+    }
+    
+    err := s.withTx(func(tx *ent.Tx) error {
+      query := tx.Character.Query().Where(
+        character.And(
+          character.Steamid(slot.Steamid),
+          character.Slot(slot.Slot),
+          character.DeletedAtIsNil(),
+        ),
+      )
+      //duplicates come from databases older than timestamps, which were always SQLite,
+      //and there the rowid is the only record of which character was saved last.
+      if drv := system.Config.Core.DBDriver; drv == "" || drv == dialect.SQLite {
+        query = query.Order(func(sel *entsql.Selector) {
+          sel.OrderBy(sel.C("rowid"))
+        })
+      }
+      
+      chars, err := query.All(s.ctx)
+      if err != nil {
+        return err
+      }
+      
+      //a later character wins a tie.
+      keep := chars[0]
+      for _,c := range chars[1:] {
+        if !newerCharacter(keep, c) {
+          keep = c
+        }
+      }
+      
+      for _,c := range chars {
+        if c.ID == keep.ID {
+          continue
+        }
+        
+        history, err := characterHistory(s.ctx, tx, c.ID)
+        if err != nil {
+          return err
+        }
+        
+        if err := characterRemove(s.ctx, tx, c.ID); err != nil {
+          return err
+        }
+        
+        if err := archiveCharacter(s.ctx, tx, keep, c, history); err != nil {
+          return err
+        }
+        
+        log.Log.Warnf("Archived duplicate character %s in slot %d of %s as a version of %s", c.ID, c.Slot, c.Steamid, keep.ID)
+        n++
+      }
+      
+      return nil
+    })
+    if err != nil {
+      return n, err
+    }
+  }
+  
+  return n, nil
+}
+
+//newerCharacter reports whether a is strictly newer than b.
+func newerCharacter(a, b *ent.Character) bool {
+  //characters saved before timestamps were added have none.
+  var at, bt time.Time
+  if a.UpdatedAt != nil {
+    at = *a.UpdatedAt
+  }
+  if b.UpdatedAt != nil {
+    bt = *b.UpdatedAt
+  }
+  return at.After(bt)
+}
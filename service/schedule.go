@@ -0,0 +1,243 @@
+package service
+
+import (
+  "os"
+  "io"
+  "fmt"
+  "sort"
+  "sync"
+  "time"
+  "strings"
+  "crypto/sha256"
+  "encoding/hex"
+  "path/filepath"
+  
+  "github.com/msrevive/nexus2/system"
+  "github.com/msrevive/nexus2/log"
+  "github.com/msrevive/nexus2/metrics"
+)
+
+//BackupRun is the outcome of one scheduled backup.
+type BackupRun struct {
+  Time time.Time `json:"time"`
+  File string `json:"file,omitempty"`
+  Size int64 `json:"size,omitempty"`
+  Sha256 string `json:"sha256,omitempty"`
+  Tables map[string]int `json:"tables,omitempty"`
+  Error string `json:"error,omitempty"`
+}
+
+type BackupStatus struct {
+  Enabled bool `json:"enabled"`
+  Healthy bool `json:"healthy"`
+  Dir string `json:"dir"`
+  Interval int `json:"interval"`
+  Keep int `json:"keep"`
+  NextRun *time.Time `json:"next_run,omitempty"`
+  LastSuccess *BackupRun `json:"last_success,omitempty"`
+  LastFailure *BackupRun `json:"last_failure,omitempty"`
+}
+
+var (
+  backupStatus BackupStatus
+  backupStatusMutex = new(sync.RWMutex)
+)
+
+func backupInterval() time.Duration {
+  return time.Duration(system.Config.Backup.Interval) * time.Minute
+}
+
+//BackupStatusGet reports how scheduled backups are doing. They're healthy while the last run
+//succeeded and it's no more than two intervals old.
+func BackupStatusGet() BackupStatus {
+  backupStatusMutex.RLock()
+  defer backupStatusMutex.RUnlock()
+  
+  status := backupStatus
+  status.Enabled = system.Config.Backup.Enable
+  status.Dir = system.Config.Backup.Dir
+  status.Interval = system.Config.Backup.Interval
+  status.Keep = system.Config.Backup.Keep
+  
+  if status.LastSuccess != nil {
+    failed := status.LastFailure != nil && status.LastFailure.Time.After(status.LastSuccess.Time)
+    stale := time.Since(status.LastSuccess.Time) > 2*backupInterval()
+    status.Healthy = !failed && !stale
+  }
+  
+  return status
+}
+
+//backup names sort by the time they were taken, oldest first.
+func backupFiles(dir string) ([]string, error) {
+  matches, err := filepath.Glob(filepath.Join(dir, "nexus2-*.tar.gz"))
+  if err != nil {
+    return nil, err
+  }
+  
+  sort.Strings(matches)
+  return matches, nil
+}
+
+//BackupsPrune removes all but the newest keep backups in dir, along with their checksums.
+func BackupsPrune(dir string, keep int) (int, error) {
+  if keep <= 0 {
+    return 0, nil
+  }
+  
+  files, err := backupFiles(dir)
+  if err != nil || len(files) <= keep {
+    return 0, err
+  }
+  
+  old := files[:len(files)-keep]
+  for _,f := range old {
+    if err := os.Remove(f); err != nil {
+      return 0, err
+    }
+    if err := os.Remove(f + ".sha256"); err != nil && !os.IsNotExist(err) {
+      return 0, err
+    }
+  }
+  
+  return len(old), nil
+}
+
+//BackupToDir writes a backup to dir along with a sha256sum style checksum file. The backup is
+//written under a temporary name first, so a crash never leaves a half written backup behind.
+func (s *service) BackupToDir(dir string) (*BackupRun, error) {
+  if err := os.MkdirAll(dir, 0700); err != nil {
+    return nil, err
+  }
+  
+  now := time.Now()
+  name := BackupName(now)
+  path := filepath.Join(dir, name)
+  tmp := path + ".tmp"
+  
+  f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+  if err != nil {
+    return nil, err
+  }
+  defer os.Remove(tmp)
+  
+  hash := sha256.New()
+  manifest, err := s.Backup(io.MultiWriter(f, hash))
+  if err == nil {
+    err = f.Sync()
+  }
+  if cerr := f.Close(); err == nil {
+    err = cerr
+  }
+  if err != nil {
+    return nil, err
+  }
+  
+  info, err := os.Stat(tmp)
+  if err != nil {
+    return nil, err
+  }
+  if err := os.Rename(tmp, path); err != nil {
+    return nil, err
+  }
+  
+  sum := hex.EncodeToString(hash.Sum(nil))
+  if err := os.WriteFile(path+".sha256", []byte(fmt.Sprintf("%s  %s\n", sum, name)), 0600); err != nil {
+    return nil, err
+  }
+  
+  return &BackupRun{
+    Time: now,
+    File: name,
+    Size: info.Size(),
+    Sha256: sum,
+    Tables: manifest.Tables,
+  }, nil
+}
+
+//pick up the newest backup already on disk, so a restart doesn't forget it or back up again straight away.
+func lastBackup(dir string) *BackupRun {
+  files, err := backupFiles(dir)
+  if err != nil || len(files) == 0 {
+    return nil
+  }
+  
+  path := files[len(files)-1]
+  info, err := os.Stat(path)
+  if err != nil {
+    return nil
+  }
+  
+  run := &BackupRun{
+    Time: info.ModTime(),
+    File: filepath.Base(path),
+    Size: info.Size(),
+  }
+  if sum, err := os.ReadFile(path + ".sha256"); err == nil {
+    run.Sha256 = strings.Fields(string(sum) + " ")[0]
+  }
+  
+  return run
+}
+
+func (s *service) backupScheduled() {
+  run, err := s.BackupToDir(system.Config.Backup.Dir)
+  
+  backupStatusMutex.Lock()
+  if err != nil {
+    backupStatus.LastFailure = &BackupRun{Time: time.Now(), Error: err.Error()}
+  }else{
+    backupStatus.LastSuccess = run
+  }
+  backupStatusMutex.Unlock()
+  
+  if err != nil {
+    log.Log.Errorf("Scheduled backup failed: %v", err)
+    metrics.BackupFailures.Inc()
+    return
+  }
+  
+  log.Log.Printf("Backed up to %s (%d bytes, sha256 %s)", run.File, run.Size, run.Sha256)
+  metrics.BackupLastSuccess.Set(float64(run.Time.Unix()))
+  
+  n, err := BackupsPrune(system.Config.Backup.Dir, system.Config.Backup.Keep)
+  if err != nil {
+    log.Log.Errorf("failed to prune old backups: %v", err)
+  }else if n > 0 {
+    log.Log.Printf("Pruned %d old backups", n)
+  }
+}
+
+//BackupsSchedule takes a backup every Backup.Interval minutes until the context is done.
+func (s *service) BackupsSchedule() {
+  interval := backupInterval()
+  
+  next := time.Now()
+  if last := lastBackup(system.Config.Backup.Dir); last != nil {
+    backupStatusMutex.Lock()
+    backupStatus.LastSuccess = last
+    backupStatusMutex.Unlock()
+    metrics.BackupLastSuccess.Set(float64(last.Time.Unix()))
+    
+    if due := last.Time.Add(interval); due.After(next) {
+      next = due
+    }
+  }
+  
+  for {
+    backupStatusMutex.Lock()
+    backupStatus.NextRun = &next
+    backupStatusMutex.Unlock()
+    
+    timer := time.NewTimer(time.Until(next))
+    select {
+    case <-s.ctx.Done():
+      timer.Stop()
+      return
+    case <-timer.C:
+    }
+    
+    s.backupScheduled()
+    next = time.Now().Add(interval)
+  }
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+  "time"
+  "errors"
+  
+  "github.com/msrevive/nexus2/ent"
+  "github.com/msrevive/nexus2/ent/server"
+  "github.com/msrevive/nexus2/system"
+)
+
+var ErrServerNotRegistered = errors.New("server is not registered")
+
+//used when Server.Timeout isn't set.
+const defaultServerTimeout = 90 * time.Second
+
+//ServerTimeout is how long a server can go without a heartbeat before it's offline.
+func ServerTimeout() time.Duration {
+  if system.Config.Server.Timeout <= 0 {
+    return defaultServerTimeout
+  }
+  
+  return time.Duration(system.Config.Server.Timeout) * time.Second
+}
+
+//a server is live while it's online and has sent a heartbeat within the timeout.
+func serverCutoff() time.Time {
+  return time.Now().Add(-ServerTimeout())
+}
+
+//ServerRegister adds a server, or brings back one that registered before under the same name.
+func (s *service) ServerRegister(newSrv ent.Server) (*ent.Server, error) {
+  var srv *ent.Server
+  err := s.withTx(func(tx *ent.Tx) error {
+    old, err := tx.Server.Query().Where(server.Name(newSrv.Name)).Only(s.ctx)
+    if err != nil && !ent.IsNotFound(err) {
+      return err
+    }
+    
+    if old == nil {
+      srv, err = tx.Server.Create().
+      SetName(newSrv.Name).
+      SetAddress(newSrv.Address).
+      SetMap(newSrv.Map).
+      SetPlayers(newSrv.Players).
+      SetMaxPlayers(newSrv.MaxPlayers).
+      Save(s.ctx)
+      return err
+    }
+    
+    srv, err = old.Update().
+    SetAddress(newSrv.Address).
+    SetMap(newSrv.Map).
+    SetPlayers(newSrv.Players).
+    SetMaxPlayers(newSrv.MaxPlayers).
+    SetOnline(true).
+    SetLastHeartbeat(time.Now()).
+    Save(s.ctx)
+    return err
+  })
+  if err != nil {
+    return nil, err
+  }
+  
+  return srv, nil
+}
+
+//ServerHeartbeat keeps a registered server live and updates its map and player count.
+func (s *service) ServerHeartbeat(name string, beat ent.Server) (*ent.Server, error) {
+  n, err := s.client.Server.Update().
+  Where(server.Name(name)).
+  SetMap(beat.Map).
+  SetPlayers(beat.Players).
+  SetOnline(true).
+  SetLastHeartbeat(time.Now()).
+  Save(s.ctx)
+  if err != nil {
+    return nil, err
+  }
+  
+  if n == 0 {
+    return nil, ErrServerNotRegistered
+  }
+  
+  return s.client.Server.Query().Where(server.Name(name)).Only(s.ctx)
+}
+
+//ServerOffline is for servers shutting down cleanly, so they don't have to wait out the timeout.
+func (s *service) ServerOffline(name string) error {
+  n, err := s.client.Server.Update().
+  Where(server.Name(name)).
+  SetOnline(false).
+  SetPlayers(0).
+  Save(s.ctx)
+  if err != nil {
+    return err
+  }
+  
+  if n == 0 {
+    return ErrServerNotRegistered
+  }
+  
+  return nil
+}
+
+func (s *service) ServersGetAll(all bool) ([]*ent.Server, error) {
+  query := s.client.Server.Query()
+  if !all {
+    query = query.Where(
+      server.And(
+        server.Online(true),
+        server.LastHeartbeatGT(serverCutoff()),
+      ),
+    )
+  }
+  
+  srvs, err := query.Order(ent.Asc(server.FieldName)).All(s.ctx)
+  if err != nil {
+    return nil, err
+  }
+  
+  return srvs, nil
+}
+
+//ServersMarkOffline marks servers that have missed their heartbeats as offline.
+func (s *service) ServersMarkOffline() (int, error) {
+  n, err := s.client.Server.Update().
+  Where(
+    server.And(
+      server.Online(true),
+      server.LastHeartbeatLTE(serverCutoff()),
+    ),
+  ).
+  SetOnline(false).
+  SetPlayers(0).
+  Save(s.ctx)
+  if err != nil {
+    return 0, err
+  }
+  
+  return n, nil
+}
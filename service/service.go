@@ -1,6 +1,7 @@
 package service
 
 import (
+  "fmt"
   "context"
   
   "github.com/msrevive/nexus2/system"
@@ -19,15 +20,44 @@ func New(ctx context.Context) *service {
   }
 }
 
+//run fn inside of a transaction, rolling back if it returns an error or panics.
+func (s *service) withTx(fn func(tx *ent.Tx) error) error {
+  tx, err := s.client.Tx(s.ctx)
+  if err != nil {
+    return err
+  }
+  
+  defer func() {
+    if v := recover(); v != nil {
+      tx.Rollback()
+      panic(v)
+    }
+  }()
+  
+  if err := fn(tx); err != nil {
+    if rerr := tx.Rollback(); rerr != nil {
+      err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+    }
+    return err
+  }
+  
+  if err := tx.Commit(); err != nil {
+    return fmt.Errorf("committing transaction: %w", err)
+  }
+  
+  return nil
+}
+
 func (s *service) Debug() error {
   _, err := s.client.Character.Create().
   SetSteamid("76561198092541763").
   SetSlot(1).
   SetData("data").
+  SetLive(true).
   Save(s.ctx)
   if err != nil {
     return err
   }
   
   return nil
-}
\ No newline at end of file
+}
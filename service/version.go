@@ -0,0 +1,197 @@
+package service
+
+import (
+  "context"
+  
+  "github.com/google/uuid"
+  
+  "github.com/msrevive/nexus2/ent"
+  "github.com/msrevive/nexus2/ent/character"
+  "github.com/msrevive/nexus2/ent/characterversion"
+  "github.com/msrevive/nexus2/ent/characterlock"
+  "github.com/msrevive/nexus2/system"
+)
+
+func nextVersion(ctx context.Context, tx *ent.Tx, uid uuid.UUID) (int, error) {
+  last, err := tx.CharacterVersion.Query().
+  Where(characterversion.CharacterID(uid)).
+  Order(ent.Desc(characterversion.FieldVersion)).
+  First(ctx)
+  if err != nil {
+    if ent.IsNotFound(err) {
+      return 1, nil
+    }
+    return 0, err
+  }
+  
+  return last.Version + 1, nil
+}
+
+//store the current data of char as its next version, then prune anything past MaxVersions.
+func snapshotCharacter(ctx context.Context, tx *ent.Tx, char *ent.Character) error {
+  max := system.Config.Character.MaxVersions
+  if max <= 0 {
+    return nil
+  }
+  
+  next, err := nextVersion(ctx, tx, char.ID)
+  if err != nil {
+    return err
+  }
+  
+  _, err = tx.CharacterVersion.Create().
+  SetCharacterID(char.ID).
+  SetVersion(next).
+  SetSize(char.Size).
+  SetData(char.Data).
+  Save(ctx)
+  if err != nil {
+    return err
+  }
+  
+  stale, err := tx.CharacterVersion.Query().
+  Where(characterversion.CharacterID(char.ID)).
+  Order(ent.Desc(characterversion.FieldVersion)).
+  Offset(max).
+  IDs(ctx)
+  if err != nil {
+    return err
+  }
+  
+  if len(stale) > 0 {
+    _, err = tx.CharacterVersion.Delete().
+    Where(characterversion.IDIn(stale...)).
+    Exec(ctx)
+    if err != nil {
+      return err
+    }
+  }
+  
+  return nil
+}
+
+//archive a character that's being removed from its slot as versions of keep, its own history
+//first and then its current data. Archived versions aren't pruned so nothing is lost until the
+//next save. The history has to be read before dup is deleted since deleting cascades to it.
+func archiveCharacter(ctx context.Context, tx *ent.Tx, keep *ent.Character, dup *ent.Character, history []*ent.CharacterVersion) error {
+  next, err := nextVersion(ctx, tx, keep.ID)
+  if err != nil {
+    return err
+  }
+  
+  bulk := make([]*ent.CharacterVersionCreate, 0, len(history)+1)
+  for _,ver := range history {
+    bulk = append(bulk, tx.CharacterVersion.Create().
+    SetCharacterID(keep.ID).
+    SetVersion(next).
+    SetSize(ver.Size).
+    SetData(ver.Data).
+    SetCreatedAt(ver.CreatedAt))
+    next++
+  }
+  
+  bulk = append(bulk, tx.CharacterVersion.Create().
+  SetCharacterID(keep.ID).
+  SetVersion(next).
+  SetSize(dup.Size).
+  SetData(dup.Data))
+  
+  return tx.CharacterVersion.CreateBulk(bulk...).Exec(ctx)
+}
+
+//remove a character along with its history.
+func characterRemove(ctx context.Context, tx *ent.Tx, uid uuid.UUID) error {
+  _, err := tx.CharacterLock.Delete().
+  Where(characterlock.CharacterID(uid)).
+  Exec(ctx)
+  if err != nil {
+    return err
+  }
+  
+  _, err = tx.CharacterVersion.Delete().
+  Where(characterversion.CharacterID(uid)).
+  Exec(ctx)
+  if err != nil {
+    return err
+  }
+  
+  return tx.Character.DeleteOneID(uid).Exec(ctx)
+}
+
+func characterHistory(ctx context.Context, tx *ent.Tx, uid uuid.UUID) ([]*ent.CharacterVersion, error) {
+  return tx.CharacterVersion.Query().
+  Where(characterversion.CharacterID(uid)).
+  Order(ent.Asc(characterversion.FieldVersion)).
+  All(ctx)
+}
+
+func (s *service) CharacterVersions(uid uuid.UUID) ([]*ent.CharacterVersion, error) {
+  //an unknown or deleted character is not found, rather than a character with no history.
+  if _, err := s.client.Character.Query().Where(
+    character.And(
+      character.ID(uid),
+      character.DeletedAtIsNil(),
+    ),
+  ).OnlyID(s.ctx); err != nil {
+    return nil, err
+  }
+  
+  versions, err := s.client.CharacterVersion.Query().
+  Where(characterversion.CharacterID(uid)).
+  Order(ent.Desc(characterversion.FieldVersion)).
+  All(s.ctx)
+  if err != nil {
+    return nil, err
+  }
+  
+  return versions, nil
+}
+
+func (s *service) CharacterRollback(uid uuid.UUID, version int) (*ent.Character, error) {
+  var char *ent.Character
+  err := s.withTx(func(tx *ent.Tx) error {
+    old, err := tx.Character.Query().Where(
+      character.And(
+        character.ID(uid),
+        character.DeletedAtIsNil(),
+      ),
+    ).Only(s.ctx)
+    if err != nil {
+      return err
+    }
+    
+    ver, err := tx.CharacterVersion.Query().
+    Where(
+      characterversion.And(
+        characterversion.CharacterID(uid),
+        characterversion.Version(version),
+      ),
+    ).Only(s.ctx)
+    if err != nil {
+      return err
+    }
+    
+    //keep what we're replacing so a bad rollback can be undone too.
+    if err := snapshotCharacter(s.ctx, tx, old); err != nil {
+      return err
+    }
+    
+    char, err = characterWrite(s.ctx, tx, old, ver.Size, ver.Data)
+    if err != nil {
+      return err
+    }
+    
+    return auditTx(s.ctx, tx, ent.AuditEvent{
+      Action: AuditCharacterRollback,
+      Steamid: char.Steamid,
+      CharacterID: &char.ID,
+      SizeBefore: &old.Size,
+      SizeAfter: &char.Size,
+    })
+  })
+  if err != nil {
+    return nil, err
+  }
+  
+  return char, nil
+}
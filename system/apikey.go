@@ -0,0 +1,130 @@
+package system
+
+import (
+  "sync"
+  "strings"
+  "io/ioutil"
+  "crypto/subtle"
+  "crypto/x509"
+
+  "github.com/goccy/go-json"
+)
+
+var (
+  KeyList map[string]*APIKey
+  SubjectList map[string]*APIKey
+  SecretList map[string]*APIKey
+  keyListMutex = new(sync.RWMutex)
+)
+
+//APIKey is a client identity, used with a bearer key, a client certificate subject, a signing secret, or any of them.
+type APIKey struct {
+  Name string `json:"-"`
+  Key string `json:"key"`
+  Subject string `json:"subject"`
+  Secret string `json:"secret"`
+  Scopes []string `json:"scopes"`
+}
+
+//admin grants every scope, an empty scope only needs a valid key.
+func (k *APIKey) HasScope(scope string) bool {
+  if scope == "" {
+    return true
+  }
+
+  for _,s := range k.Scopes {
+    if s == scope || s == ScopeAdmin {
+      return true
+    }
+  }
+
+  return false
+}
+
+//The key file maps a key name to its key, certificate subject and/or signing secret and scopes, it's indexed by each once loaded.
+func LoadKeyList(path string) error {
+  file,err := ioutil.ReadFile(path)
+  if err != nil {
+    return err
+  }
+
+  var named map[string]*APIKey
+  if err := json.Unmarshal(file, &named); err != nil {
+    return err
+  }
+
+  list := make(map[string]*APIKey, len(named))
+  subjects := make(map[string]*APIKey)
+  secrets := make(map[string]*APIKey)
+  for name,k := range named {
+    if k == nil {
+      continue
+    }
+
+    k.Name = name
+    if k.Key != "" {
+      list[k.Key] = k
+    }
+    if k.Subject != "" {
+      subjects[k.Subject] = k
+    }
+    if k.Secret != "" {
+      secrets[name] = k
+    }
+  }
+
+  keyListMutex.Lock()
+  KeyList = list
+  SubjectList = subjects
+  SecretList = secrets
+  keyListMutex.Unlock()
+
+  return nil
+}
+
+//find the API key for the given Authorization header, with or without a "Bearer " prefix.
+//The single key from ApiAuth.Key is still accepted as "default" with the admin scope.
+func APIKeyGet(token string) (*APIKey, bool) {
+  token = strings.TrimPrefix(token, "Bearer ")
+  if token == "" {
+    return nil, false
+  }
+
+  keyListMutex.RLock()
+  k,ok := KeyList[token]
+  keyListMutex.RUnlock()
+  if ok {
+    return k, true
+  }
+
+  if Config.ApiAuth.Key != "" && subtle.ConstantTimeCompare([]byte(token), []byte(Config.ApiAuth.Key)) == 1 {
+    return &APIKey{Name: "default", Key: Config.ApiAuth.Key, Scopes: []string{ScopeAdmin}}, true
+  }
+
+  return nil, false
+}
+
+//find the identity for a verified client certificate, by its full subject (CN=eu1,O=MSRevive) or just its common name.
+func APIKeyGetByCert(cert *x509.Certificate) (*APIKey, bool) {
+  keyListMutex.RLock()
+  defer keyListMutex.RUnlock()
+
+  if k,ok := SubjectList[cert.Subject.String()]; ok {
+    return k, true
+  }
+  if cert.Subject.CommonName == "" {
+    return nil, false
+  }
+
+  k,ok := SubjectList[cert.Subject.CommonName]
+  return k, ok
+}
+
+//find the identity that signs requests as name, only keys with a secret can sign.
+func APIKeyGetBySigner(name string) (*APIKey, bool) {
+  keyListMutex.RLock()
+  defer keyListMutex.RUnlock()
+
+  k,ok := SecretList[name]
+  return k, ok
+}
@@ -1,3 +1,15 @@
 package system
 
-const Version = "v1.0.4"
\ No newline at end of file
+const Version = "v1.0.4"
+
+//API key scopes, each route declares the one it needs.
+const (
+  ScopeCharRead = "character:read"
+  ScopeCharWrite = "character:write"
+  ScopeBanRead = "ban:read"
+  ScopeBanWrite = "ban:write"
+  ScopeServerRead = "server:read"
+  ScopeServerWrite = "server:write"
+  ScopeMetrics = "metrics"
+  ScopeAdmin = "admin"
+)
@@ -0,0 +1,71 @@
+package system
+
+import (
+  "net"
+)
+
+//ipTrie is a binary trie over address bits. IPv4 is stored in its IPv4-mapped IPv6 form,
+//so both families share one trie and a lookup is at most 128 steps.
+type ipTrie struct {
+  root ipNode
+  size int
+}
+
+type ipNode struct {
+  child [2]*ipNode
+  allow bool
+  deny bool
+}
+
+func ipBit(ip net.IP, i int) int {
+  return int(ip[i/8] >> (7 - uint(i%8))) & 1
+}
+
+//insert marks every address in block as allowed or denied.
+func (t *ipTrie) insert(block *net.IPNet, allow bool) {
+  ones, bits := block.Mask.Size()
+  if bits == 32 {
+    ones += 96
+  }
+  
+  ip := block.IP.To16()
+  n := &t.root
+  for i := 0; i < ones; i++ {
+    b := ipBit(ip, i)
+    if n.child[b] == nil {
+      n.child[b] = &ipNode{}
+    }
+    n = n.child[b]
+  }
+  
+  if allow {
+    n.allow = true
+  }else{
+    n.deny = true
+  }
+  t.size++
+}
+
+//allowed reports whether ip falls in an allowed block and no denied one, deny wins no matter which block is narrower.
+func (t *ipTrie) allowed(ip net.IP) bool {
+  ip = ip.To16()
+  if ip == nil {
+    return false
+  }
+  
+  allow := false
+  n := &t.root
+  for i := 0; n != nil; i++ {
+    if n.deny {
+      return false
+    }
+    allow = allow || n.allow
+    
+    if i == 128 {
+      break
+    }
+    n = n.child[ipBit(ip, i)]
+  }
+  
+  return allow
+}
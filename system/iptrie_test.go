@@ -0,0 +1,85 @@
+package system
+
+import (
+  "net"
+  "testing"
+)
+
+func TestIPTrieAllowed(t *testing.T) {
+  tests := []struct {
+    name string
+    entries map[string]bool
+    ip string
+    want bool
+  }{
+    {"empty list", nil, "192.0.2.1", false},
+    {"allowed address", map[string]bool{"192.0.2.1": true}, "192.0.2.1", true},
+    {"other address", map[string]bool{"192.0.2.1": true}, "192.0.2.2", false},
+    {"in allowed block", map[string]bool{"192.0.2.0/24": true}, "192.0.2.200", true},
+    {"outside allowed block", map[string]bool{"192.0.2.0/24": true}, "192.0.3.1", false},
+    {"denied address", map[string]bool{"192.0.2.1": false}, "192.0.2.1", false},
+    {"narrower deny in allowed block", map[string]bool{"192.0.2.0/24": true, "192.0.2.128/25": false}, "192.0.2.200", false},
+    {"allowed beside narrower deny", map[string]bool{"192.0.2.0/24": true, "192.0.2.128/25": false}, "192.0.2.1", true},
+    {"wider deny over allowed address", map[string]bool{"192.0.2.0/24": false, "192.0.2.1": true}, "192.0.2.1", false},
+    {"deny and allow on one block", map[string]bool{"10.0.0.0/8": true, "10.0.0.0/8 ": false}, "10.1.2.3", false},
+    {"allow all", map[string]bool{"0.0.0.0/0": true}, "203.0.113.9", true},
+    {"ipv6 block", map[string]bool{"2001:db8::/32": true}, "2001:db8:1::1", true},
+    {"outside ipv6 block", map[string]bool{"2001:db8::/32": true}, "2001:db9::1", false},
+    {"ipv6 deny in block", map[string]bool{"2001:db8::/32": true, "2001:db8::1": false}, "2001:db8::1", false},
+    {"ipv4 entry, mapped address", map[string]bool{"192.0.2.0/24": true}, "::ffff:192.0.2.7", true},
+    {"ipv4 entry, ipv6 address", map[string]bool{"192.0.2.0/24": true}, "2001:db8::1", false},
+    {"ipv6 entry, ipv4 address", map[string]bool{"::/0": true, "192.0.2.0/24": false}, "192.0.2.7", false},
+  }
+
+  for _,tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      list := &ipTrie{}
+      for entry,allow := range tt.entries {
+        block, err := ParseCIDR(entry)
+        if err != nil {
+          t.Fatal(err)
+        }
+        list.insert(block, allow)
+      }
+
+      if got := list.allowed(net.ParseIP(tt.ip)); got != tt.want {
+        t.Errorf("allowed(%s) = %v, want %v", tt.ip, got, tt.want)
+      }
+    })
+  }
+}
+
+func TestParseCIDR(t *testing.T) {
+  tests := []struct {
+    in string
+    want string
+    err bool
+  }{
+    {"192.0.2.1", "192.0.2.1/32", false},
+    {" 192.0.2.0/24 ", "192.0.2.0/24", false},
+    {"192.0.2.9/24", "192.0.2.0/24", false},
+    {"2001:db8::1", "2001:db8::1/128", false},
+    {"2001:db8::/32", "2001:db8::/32", false},
+    {"nexus", "", true},
+    {"192.0.2.0/33", "", true},
+  }
+
+  for _,tt := range tests {
+    t.Run(tt.in, func(t *testing.T) {
+      block, err := ParseCIDR(tt.in)
+      if tt.err {
+        if err == nil {
+          t.Errorf("ParseCIDR(%q) = %s, want an error", tt.in, block)
+        }
+        return
+      }
+
+      if err != nil {
+        t.Fatal(err)
+      }
+      if block.String() != tt.want {
+        t.Errorf("ParseCIDR(%q) = %s, want %s", tt.in, block, tt.want)
+      }
+    })
+  }
+}
@@ -0,0 +1,58 @@
+package system
+
+import (
+  "net"
+  "fmt"
+  "strings"
+)
+
+var trustedProxies []*net.IPNet
+
+//ParseCIDR parses a CIDR block, a bare address is taken as a block holding just that address.
+func ParseCIDR(s string) (*net.IPNet, error) {
+  s = strings.TrimSpace(s)
+  if strings.Contains(s, "/") {
+    _, block, err := net.ParseCIDR(s)
+    return block, err
+  }
+  
+  ip := net.ParseIP(s)
+  if ip == nil {
+    return nil, fmt.Errorf("invalid address %q", s)
+  }
+  if ip4 := ip.To4(); ip4 != nil {
+    return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+  }
+  
+  return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+}
+
+//LoadTrustedProxies replaces the blocks forwarded headers are believed from, LoadConfig loads Core.TrustedProxies.
+func LoadTrustedProxies(list []string) error {
+  var blocks []*net.IPNet
+  for _,s := range list {
+    if strings.TrimSpace(s) == "" {
+      continue
+    }
+    
+    block, err := ParseCIDR(s)
+    if err != nil {
+      return fmt.Errorf("Core.TrustedProxies: %w", err)
+    }
+    blocks = append(blocks, block)
+  }
+  
+  trustedProxies = blocks
+  return nil
+}
+
+//IsTrustedProxy reports whether forwarded headers from ip can be believed.
+func IsTrustedProxy(ip net.IP) bool {
+  for _,block := range trustedProxies {
+    if block.Contains(ip) {
+      return true
+    }
+  }
+  
+  return false
+}
@@ -1,6 +1,7 @@
 package system
 
 import(
+  "net"
   "time"
   "sync"
   "errors"
@@ -19,7 +20,7 @@ var (
   Config config
   Dbg bool
   
-  IPList map[string]bool
+  IPList *ipTrie
   iPListMutex = new(sync.RWMutex)
   BanList map[string]bool
   banListMutex = new(sync.RWMutex)
@@ -27,6 +28,8 @@ var (
   mapListMutex = new(sync.RWMutex)
   AdminList map[string]bool
   adminListMutex = new(sync.RWMutex)
+  RateLimitList map[string]int
+  rateLimitListMutex = new(sync.RWMutex)
 )
 
 type config struct {
@@ -36,22 +39,35 @@ type config struct {
     MaxThreads int
     Graceful time.Duration
     RootPath string
+    DBDriver string
     DBString string
+    TrustedProxies []string
   }
   RateLimit struct {
     Enable bool
     MaxRequests int
     MaxAge time.Duration
+    OverrideFile string
   }
   Cert struct {
     Enable bool
     Domain string
+    CacheDir string
+    CertFile string
+    KeyFile string
+    RedirectHTTP bool
+    HTTPAddress string
+    ClientCAFile string
+    RequireClientCert bool
   }
   ApiAuth struct {
     EnforceKey bool
     EnforceIP bool
     Key string
+    KeyFile string
     IPListFile string
+    SignatureWindow int
+    NonceCacheSize int
   }
   Verify struct {
     EnforceBan bool
@@ -62,6 +78,31 @@ type config struct {
     AdminListFile string
     SCHash uint32
   }
+  Metrics struct {
+    Enable bool
+  }
+  Character struct {
+    MaxVersions int
+    PurgeDays int
+    EnforceVersion bool
+    LockTTL int
+    MaxLockTTL int
+    ValidateData bool
+  }
+  Server struct {
+    Timeout int
+  }
+  Anomaly struct {
+    Enable bool
+    Action string
+    MaxSizeGrowth int
+  }
+  Backup struct {
+    Enable bool
+    Interval int
+    Dir string
+    Keep int
+  }
   Log struct {
     Level string
     Dir string
@@ -75,8 +116,6 @@ func LoadConfig(path string) error {
     if err := ini.MapTo(&Config, path); err != nil {
       return err
     }
-    
-    return nil
   case ".yaml", ".json":
     data,err := ioutil.ReadFile(path)
     if data != nil {
@@ -86,21 +125,39 @@ func LoadConfig(path string) error {
     if err != nil {
       return err
     }
-    
-    return nil
   default:
     return errors.New("unsupported config type")
   }
+  
+  return LoadTrustedProxies(Config.Core.TrustedProxies)
 }
 
+//Each list is parsed into a new map and only swapped in once it loads cleanly,
+//so a bad file leaves the current list in place.
+//IP list keys are addresses or CIDR blocks, IPv4 or IPv6. true allows them and false denies them,
+//a denied address stays denied even if a wider or narrower block allows it.
 func LoadIPList(path string) error {
   file,err := ioutil.ReadFile(path)
   if err != nil {
     return err
   }
   
+  var entries map[string]bool
+  if err := json.Unmarshal(file, &entries); err != nil {
+    return err
+  }
+  
+  list := &ipTrie{}
+  for entry,allow := range entries {
+    block, err := ParseCIDR(entry)
+    if err != nil {
+      return err
+    }
+    list.insert(block, allow)
+  }
+  
   iPListMutex.Lock()
-  _ = json.Unmarshal([]byte(file), &IPList)
+  IPList = list
   iPListMutex.Unlock()
   
   return nil
@@ -112,8 +169,13 @@ func LoadMapList(path string) error {
     return err
   }
   
+  var list map[string]uint32
+  if err := json.Unmarshal(file, &list); err != nil {
+    return err
+  }
+  
   mapListMutex.Lock()
-  _ = json.Unmarshal([]byte(file), &MapList)
+  MapList = list
   mapListMutex.Unlock()
   
   return nil
@@ -125,8 +187,13 @@ func LoadBanList(path string) error {
     return err
   }
   
+  var list map[string]bool
+  if err := json.Unmarshal(file, &list); err != nil {
+    return err
+  }
+  
   banListMutex.Lock()
-  _ = json.Unmarshal([]byte(file), &BanList)
+  BanList = list
   banListMutex.Unlock()
   
   return nil
@@ -138,9 +205,104 @@ func LoadAdminList(path string) error {
     return err
   }
   
+  var list map[string]bool
+  if err := json.Unmarshal(file, &list); err != nil {
+    return err
+  }
+  
   adminListMutex.Lock()
-  _ = json.Unmarshal([]byte(file), &AdminList)
+  AdminList = list
   adminListMutex.Unlock()
   
   return nil
 }
+
+func LoadRateLimitList(path string) error {
+  file,err := ioutil.ReadFile(path)
+  if err != nil {
+    return err
+  }
+  
+  var list map[string]int
+  if err := json.Unmarshal(file, &list); err != nil {
+    return err
+  }
+  
+  rateLimitListMutex.Lock()
+  RateLimitList = list
+  rateLimitListMutex.Unlock()
+  
+  return nil
+}
+
+func IPListHas(ip string) bool {
+  addr := net.ParseIP(ip)
+  if addr == nil {
+    return false
+  }
+  
+  iPListMutex.RLock()
+  defer iPListMutex.RUnlock()
+  
+  return IPList != nil && IPList.allowed(addr)
+}
+
+func MapListGet(name string) (uint32, bool) {
+  mapListMutex.RLock()
+  defer mapListMutex.RUnlock()
+  
+  hash,ok := MapList[name]
+  return hash, ok
+}
+
+func IPListLen() int {
+  iPListMutex.RLock()
+  defer iPListMutex.RUnlock()
+  
+  if IPList == nil {
+    return 0
+  }
+  return IPList.size
+}
+
+func MapListLen() int {
+  mapListMutex.RLock()
+  defer mapListMutex.RUnlock()
+  
+  return len(MapList)
+}
+
+func BanListCopy() map[string]bool {
+  banListMutex.RLock()
+  defer banListMutex.RUnlock()
+  
+  list := make(map[string]bool, len(BanList))
+  for k,v := range BanList {
+    list[k] = v
+  }
+  
+  return list
+}
+
+func AdminListHas(steamid string) bool {
+  adminListMutex.RLock()
+  defer adminListMutex.RUnlock()
+  
+  _,ok := AdminList[steamid]
+  return ok
+}
+
+func AdminListLen() int {
+  adminListMutex.RLock()
+  defer adminListMutex.RUnlock()
+  
+  return len(AdminList)
+}
+
+func RateLimitListGet(id string) (int, bool) {
+  rateLimitListMutex.RLock()
+  defer rateLimitListMutex.RUnlock()
+  
+  max,ok := RateLimitList[id]
+  return max, ok
+}
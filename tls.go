@@ -0,0 +1,163 @@
+package main
+
+import (
+  "os"
+  "fmt"
+  "net"
+  "sync"
+  "time"
+  "context"
+  "strconv"
+  "strings"
+  "net/http"
+  "crypto/tls"
+  "crypto/x509"
+  
+  "github.com/msrevive/nexus2/system"
+  "github.com/msrevive/nexus2/log"
+  
+  "golang.org/x/crypto/acme"
+)
+
+//clientAuth sets cfg up to verify client certificates against Cert.ClientCAFile. Certificates
+//are optional unless Cert.RequireClientCert is set, clients without one can still use bearer keys.
+//viaACME is set when certificates come from autocert, which has to answer tls-alpn-01 challenges.
+func clientAuth(cfg *tls.Config, viaACME bool) error {
+  if system.Config.Cert.ClientCAFile == "" {
+    return nil
+  }
+  
+  pem, err := os.ReadFile(system.Config.Cert.ClientCAFile)
+  if err != nil {
+    return err
+  }
+  
+  pool := x509.NewCertPool()
+  if !pool.AppendCertsFromPEM(pem) {
+    return fmt.Errorf("no certificates found in %s", system.Config.Cert.ClientCAFile)
+  }
+  
+  cfg.ClientCAs = pool
+  cfg.ClientAuth = tls.VerifyClientCertIfGiven
+  if system.Config.Cert.RequireClientCert {
+    cfg.ClientAuth = tls.RequireAndVerifyClientCert
+    
+    if viaACME {
+      cfg.GetConfigForClient = acmeChallenge(cfg)
+    }
+  }
+  
+  return nil
+}
+
+//acmeChallenge lets ACME tls-alpn-01 challenges through without a client certificate. The validation
+//server only offers acme-tls/1, so a hello offering anything else alongside it is held to cfg.
+func acmeChallenge(cfg *tls.Config) func(*tls.ClientHelloInfo) (*tls.Config, error) {
+  return func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+    if len(hello.SupportedProtos) != 1 || hello.SupportedProtos[0] != acme.ALPNProto {
+      return nil, nil
+    }
+    
+    c := cfg.Clone()
+    c.ClientAuth = tls.NoClientCert
+    c.GetConfigForClient = nil
+    return c, nil
+  }
+}
+
+//certReloader serves the certificate in CertFile and KeyFile, loading them again when either changes on disk.
+type certReloader struct {
+  certFile string
+  keyFile string
+  
+  sync.RWMutex
+  cert *tls.Certificate
+  tried time.Time
+}
+
+func newCertReloader(certFile, keyFile string) (*certReloader, error) {
+  cr := &certReloader{certFile: certFile, keyFile: keyFile}
+  cr.tried = cr.modTime()
+  if err := cr.load(); err != nil {
+    return nil, err
+  }
+  
+  return cr, nil
+}
+
+//modTime is when the certificate or key last changed, whichever is later.
+func (cr *certReloader) modTime() time.Time {
+  var latest time.Time
+  for _,path := range []string{cr.certFile, cr.keyFile} {
+    if info, err := os.Stat(path); err == nil && info.ModTime().After(latest) {
+      latest = info.ModTime()
+    }
+  }
+  
+  return latest
+}
+
+func (cr *certReloader) load() error {
+  cert, err := tls.LoadX509KeyPair(cr.certFile, cr.keyFile)
+  if err != nil {
+    return err
+  }
+  
+  cr.Lock()
+  cr.cert = &cert
+  cr.Unlock()
+  return nil
+}
+
+func (cr *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+  cr.RLock()
+  defer cr.RUnlock()
+  return cr.cert, nil
+}
+
+//poll the files and load them again when they change, until ctx is done. A pair that fails
+//to load keeps the current certificate, it's tried again once either file changes again.
+func (cr *certReloader) watch(ctx context.Context, interval time.Duration) {
+  ticker := time.NewTicker(interval)
+  defer ticker.Stop()
+  
+  for {
+    select {
+    case <-ctx.Done():
+      return
+    case <-ticker.C:
+      mod := cr.modTime()
+      if mod.IsZero() || mod.Equal(cr.tried) {
+        continue
+      }
+      
+      cr.tried = mod
+      if err := cr.load(); err != nil {
+        log.Log.Warnf("Failed to reload certificate %s: %v", cr.certFile, err)
+        continue
+      }
+      log.Log.Printf("Reloaded certificate %s", cr.certFile)
+    }
+  }
+}
+
+//redirectHTTPS sends plain HTTP clients to the same URL over HTTPS. Only GET and HEAD are redirected,
+//anything else may have already sent a key or body in the clear and shouldn't be repeated.
+func redirectHTTPS(w http.ResponseWriter, r *http.Request) {
+  if r.Method != http.MethodGet && r.Method != http.MethodHead {
+    http.Error(w, "Use HTTPS", http.StatusBadRequest)
+    return
+  }
+  
+  host := r.Host
+  if h,_,err := net.SplitHostPort(host); err == nil {
+    host = h
+  }else{
+    host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+  }
+  if system.Config.Core.Port != 443 {
+    host = net.JoinHostPort(host, strconv.Itoa(system.Config.Core.Port))
+  }
+  
+  http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusFound)
+}